@@ -0,0 +1,83 @@
+package epochdate
+
+import "time"
+
+// Feb29Policy specifies when the anniversaries of February 29 are observed
+// in common years.
+//
+type Feb29Policy int
+
+const (
+	// Feb29OnFeb28 observes the anniversaries of February 29 on February 28
+	// in common years, keeping them within the same month.
+	Feb29OnFeb28 Feb29Policy = iota
+
+	// Feb29OnMar1 observes the anniversaries of February 29 on March 1 in
+	// common years, the day after February 28, as some jurisdictions do for
+	// the purpose of legal ages.
+	Feb29OnMar1
+)
+
+// anniversary returns the anniversary in the given year of a date with the
+// given month and day, as a Unix time, so that it may be compared beyond the
+// representable range.
+//
+func anniversary(year int, month time.Month, day int, feb29 Feb29Policy) int64 {
+	if month == time.February && day == 29 && daysIn(year, month) == 28 {
+		if feb29 == Feb29OnMar1 {
+			month, day = time.March, 1
+		} else {
+			day = 28
+		}
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// NextAnniversary returns the first anniversary of the date of after the
+// date after, such as the next birthday after today of a person born on of.
+// Anniversaries occur in the years following that of of, on the same month
+// and day, except that the anniversaries of February 29 in common years are
+// observed according to feb29. It returns ErrOutOfRange if the anniversary
+// is not representable.
+//
+func NextAnniversary(of, after Date, feb29 Feb29Policy) (Date, error) {
+	y0, m, d := of.Date()
+	year, _, _ := after.Date()
+	if year <= y0 {
+		year = y0 + 1
+	}
+	for {
+		t := anniversary(year, m, d, feb29)
+		if t > after.Unix() {
+			if t > maxUnix {
+				return 0, ErrOutOfRange
+			}
+			return Date(t / day), nil
+		}
+		year++
+	}
+}
+
+// IsAnniversaryOf reports whether d is an anniversary of the date of, as
+// defined by NextAnniversary. In particular, of is not its own anniversary.
+//
+func (d Date) IsAnniversaryOf(of Date, feb29 Feb29Policy) bool {
+	year, _, _ := d.Date()
+	y0, m, dd := of.Date()
+	return year > y0 && anniversary(year, m, dd, feb29) == d.Unix()
+}
+
+// AddYears returns the date n years after d, or before d if n is negative,
+// on the same month and day, except that February 29 becomes the date in
+// common years given by feb29. This suits year-over-year comparisons, which
+// should neither skip nor duplicate the end of February. It returns
+// ErrOutOfRange if the result is not representable.
+//
+func (d Date) AddYears(n int, feb29 Feb29Policy) (Date, error) {
+	year, month, dom := d.Date()
+	t := anniversary(year+n, month, dom, feb29)
+	if !UnixInRange(t) {
+		return 0, ErrOutOfRange
+	}
+	return Date(t / day), nil
+}
@@ -0,0 +1,110 @@
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestNextAnniversary(t *testing.T) {
+	tests := []struct {
+		of, after string
+		feb29     Feb29Policy
+		want      string
+	}{
+		{"1987-06-15", "2024-03-01", Feb29OnFeb28, "2024-06-15"},
+		{"1987-06-15", "2024-06-14", Feb29OnFeb28, "2024-06-15"},
+		{"1987-06-15", "2024-06-15", Feb29OnFeb28, "2025-06-15"},
+		{"1987-06-15", "1970-01-01", Feb29OnFeb28, "1988-06-15"},
+		{"1987-06-15", "1987-06-15", Feb29OnFeb28, "1988-06-15"},
+		{"1988-02-29", "2023-01-01", Feb29OnFeb28, "2023-02-28"},
+		{"1988-02-29", "2023-01-01", Feb29OnMar1, "2023-03-01"},
+		{"1988-02-29", "2023-02-28", Feb29OnFeb28, "2024-02-29"},
+		{"1988-02-29", "2023-02-28", Feb29OnMar1, "2023-03-01"},
+		{"1988-02-29", "2024-01-01", Feb29OnMar1, "2024-02-29"},
+		{"2000-03-01", "2023-02-28", Feb29OnMar1, "2023-03-01"},
+	}
+	for _, tt := range tests {
+		got, err := NextAnniversary(MustParseRFC(tt.of), MustParseRFC(tt.after), tt.feb29)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("NextAnniversary(%s, %s, %d) = %v, %v; want %s", tt.of, tt.after, tt.feb29, got, err, tt.want)
+		}
+	}
+
+	if d, err := NextAnniversary(MustParseRFC("2000-12-25"), MustParseRFC("2148-12-25"), Feb29OnFeb28); err != ErrOutOfRange {
+		t.Errorf("NextAnniversary beyond the range = %v, %v; want ErrOutOfRange", d, err)
+	}
+}
+
+func TestIsAnniversaryOf(t *testing.T) {
+	of := MustParseRFC("1988-02-29")
+	tests := []struct {
+		date  string
+		feb29 Feb29Policy
+		want  bool
+	}{
+		{"1988-02-29", Feb29OnFeb28, false},
+		{"1992-02-29", Feb29OnFeb28, true},
+		{"1992-02-28", Feb29OnFeb28, false},
+		{"1993-02-28", Feb29OnFeb28, true},
+		{"1993-03-01", Feb29OnFeb28, false},
+		{"1993-03-01", Feb29OnMar1, true},
+		{"1993-02-28", Feb29OnMar1, false},
+	}
+	for _, tt := range tests {
+		if got := MustParseRFC(tt.date).IsAnniversaryOf(of, tt.feb29); got != tt.want {
+			t.Errorf("%s.IsAnniversaryOf(%v, %d) = %v, want %v", tt.date, of, tt.feb29, got, tt.want)
+		}
+	}
+
+	f := func(of, after Date, mar1 bool) bool {
+		feb29 := Feb29OnFeb28
+		if mar1 {
+			feb29 = Feb29OnMar1
+		}
+		next, err := NextAnniversary(of, after, feb29)
+		if err != nil {
+			return true
+		}
+		if next <= after || !next.IsAnniversaryOf(of, feb29) {
+			return false
+		}
+		// No anniversary lies between after and next.
+		for d := after + 1; d < next; d++ {
+			if d.IsAnniversaryOf(of, feb29) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 20}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAddYears(t *testing.T) {
+	tests := []struct {
+		date  string
+		n     int
+		feb29 Feb29Policy
+		want  string
+	}{
+		{"2024-03-01", -1, Feb29OnFeb28, "2023-03-01"},
+		{"2024-02-29", -1, Feb29OnFeb28, "2023-02-28"},
+		{"2024-02-29", -1, Feb29OnMar1, "2023-03-01"},
+		{"2024-02-29", 4, Feb29OnMar1, "2028-02-29"},
+		{"2023-02-28", 1, Feb29OnMar1, "2024-02-28"},
+		{"2024-12-31", 0, Feb29OnFeb28, "2024-12-31"},
+		{"1971-06-01", -1, Feb29OnFeb28, "1970-06-01"},
+	}
+	for _, tt := range tests {
+		got, err := MustParseRFC(tt.date).AddYears(tt.n, tt.feb29)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("%s.AddYears(%d, %d) = %v, %v; want %s", tt.date, tt.n, tt.feb29, got, err, tt.want)
+		}
+	}
+	for _, n := range []int{-1, 180} {
+		if got, err := MustParseRFC("1970-06-01").AddYears(n, Feb29OnFeb28); err != ErrOutOfRange {
+			t.Errorf("AddYears(%d) = %v, %v; want ErrOutOfRange", n, got, err)
+		}
+	}
+}
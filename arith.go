@@ -0,0 +1,48 @@
+package epochdate
+
+// AddDays returns the date n days after d, or before d if n is negative.
+// Unlike adding to the underlying integer, which silently wraps around at
+// the ends of the representable range, ErrOutOfRange is returned if the
+// result is not representable.
+//
+func (d Date) AddDays(n int) (Date, error) {
+	// Check n first, so that the sum cannot overflow.
+	if n < -maxDate || n > maxDate || int(d)+n < 0 || int(d)+n > maxDate {
+		return 0, ErrOutOfRange
+	}
+	return Date(int(d) + n), nil
+}
+
+// ClampAddDays is like AddDays, except that it saturates at the minimum or
+// maximum Date, rather than returning an error.
+//
+func (d Date) ClampAddDays(n int) Date {
+	switch {
+	case n < -maxDate:
+		return 0
+	case n > maxDate:
+		return maxDate
+	}
+	return clampDays(int(d) + n)
+}
+
+// Sub returns the number of days from other until d, which is negative if d
+// is before other, such that other.AddDays(d.Sub(other)) returns d.
+//
+func (d Date) Sub(other Date) int {
+	return int(d) - int(other)
+}
+
+// AddDate returns the date the given numbers of years, months, and days
+// after d, as time.Time.AddDate does, including its normalization of
+// overflowing days, so that January 31 plus one month is March 2 (or 3).
+// AddPeriod instead moves such dates to the end of the shorter month.
+// ErrOutOfRange is returned if the result is not representable.
+//
+func (d Date) AddDate(years, months, days int) (Date, error) {
+	t := d.UTC().AddDate(years, months, days).Unix()
+	if !UnixInRange(t) {
+		return 0, ErrOutOfRange
+	}
+	return Date(t / day), nil
+}
@@ -0,0 +1,74 @@
+package epochdate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDate_AddDays(t *testing.T) {
+	d := MustParseRFC("2024-02-28")
+	tests := []struct {
+		d       Date
+		n       int
+		want    Date
+		wantErr bool
+		clamped Date
+	}{
+		{d, 0, d, false, d},
+		{d, 2, MustParseRFC("2024-03-01"), false, MustParseRFC("2024-03-01")},
+		{d, -59, MustParseRFC("2023-12-31"), false, MustParseRFC("2023-12-31")},
+		{0, -1, 0, true, 0},
+		{maxDate, 1, 0, true, maxDate},
+		{0, maxDate, maxDate, false, maxDate},
+		{d, math.MaxInt32, 0, true, maxDate},
+		{d, math.MinInt32, 0, true, 0},
+	}
+	for _, test := range tests {
+		got, err := test.d.AddDays(test.n)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("%v.AddDays(%d) = %v, %v; want %v, error %v", test.d, test.n, got, err, test.want, test.wantErr)
+		}
+		if got := test.d.ClampAddDays(test.n); got != test.clamped {
+			t.Errorf("%v.ClampAddDays(%d) = %v; want %v", test.d, test.n, got, test.clamped)
+		}
+	}
+}
+
+func TestDate_Sub(t *testing.T) {
+	a, b := MustParseRFC("2024-01-01"), MustParseRFC("2024-03-01")
+	if got := b.Sub(a); got != 60 {
+		t.Errorf("b.Sub(a) = %d; want 60", got)
+	}
+	if got := a.Sub(b); got != -60 {
+		t.Errorf("a.Sub(b) = %d; want -60", got)
+	}
+	if got, err := a.AddDays(b.Sub(a)); err != nil || got != b {
+		t.Errorf("a.AddDays(b.Sub(a)) = %v, %v; want %v", got, err, b)
+	}
+}
+
+func TestDate_AddDate(t *testing.T) {
+	tests := []struct {
+		d                   string
+		years, months, days int
+		want                string
+	}{
+		{"2024-01-31", 0, 1, 0, "2024-03-02"},
+		{"2023-01-31", 0, 1, 0, "2023-03-03"},
+		{"2024-02-29", 1, 0, 0, "2025-03-01"},
+		{"2024-03-01", 0, 0, -1, "2024-02-29"},
+		{"2024-03-15", -1, 13, 20, "2024-05-05"},
+	}
+	for _, test := range tests {
+		got, err := MustParseRFC(test.d).AddDate(test.years, test.months, test.days)
+		if err != nil || got.String() != test.want {
+			t.Errorf("%s.AddDate(%d, %d, %d) = %v, %v; want %s", test.d, test.years, test.months, test.days, got, err, test.want)
+		}
+	}
+	if _, err := Date(maxDate).AddDate(0, 0, 1); err != ErrOutOfRange {
+		t.Errorf("max.AddDate(0, 0, 1) error = %v; want ErrOutOfRange", err)
+	}
+	if _, err := Date(0).AddDate(0, -1, 0); err != ErrOutOfRange {
+		t.Errorf("min.AddDate(0, -1, 0) error = %v; want ErrOutOfRange", err)
+	}
+}
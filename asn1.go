@@ -0,0 +1,79 @@
+package epochdate
+
+import (
+	"errors"
+	"time"
+)
+
+// Layouts of the ASN.1 UTCTime and GeneralizedTime forms accepted by
+// ParseUTCTime and ParseGeneralizedTime. UTCTime values are expanded to
+// four-digit years before parsing, so both use the same layouts. Fractional
+// seconds are accepted after the seconds by time.Parse.
+//
+var asn1Layouts = []string{
+	"20060102150405Z0700",
+	"200601021504Z0700",
+	"2006010215Z0700",
+}
+
+var (
+	errASN1Syntax   = errors.New("epochdate: invalid ASN.1 time")
+	errUTCTimeRange = errors.New("epochdate: UTCTime years must be in the range [1950,2049]")
+)
+
+// ParseUTCTime returns the date portion of an ASN.1 UTCTime value, such as
+// "240301120000Z". As specified by RFC 5280, two-digit years of 50 or more
+// are in the 1900s, and others are in the 2000s. The date is as written,
+// which for values with a UTC offset, such as "2403010100+0200", is the date
+// in that offset.
+//
+func ParseUTCTime(s string) (Date, error) {
+	if len(s) < 2 || !isDigit(s[0]) || !isDigit(s[1]) {
+		return 0, errASN1Syntax
+	}
+	century := "20"
+	if s[0] >= '5' {
+		century = "19"
+	}
+	return parseASN1(century + s)
+}
+
+// ParseGeneralizedTime returns the date portion of an ASN.1 GeneralizedTime
+// value, such as "20240301120000Z". The hour, and optionally minutes,
+// seconds, and fractional seconds, must be present, followed by "Z" or a UTC
+// offset; as with ParseUTCTime, the date is as written.
+//
+func ParseGeneralizedTime(s string) (Date, error) {
+	return parseASN1(s)
+}
+
+func parseASN1(s string) (Date, error) {
+	for _, layout := range asn1Layouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return NewFromTime(t)
+		}
+	}
+	return 0, errASN1Syntax
+}
+
+// FormatUTCTime returns the ASN.1 UTCTime representation of midnight UTC on
+// d, such as "240301000000Z", or an error if the year of d cannot be
+// represented by UTCTime.
+//
+func (d Date) FormatUTCTime() (string, error) {
+	if y, _, _ := d.Date(); y >= 2050 {
+		return "", errUTCTimeRange
+	}
+	return d.Format("060102") + "000000Z", nil
+}
+
+// FormatGeneralizedTime returns the ASN.1 GeneralizedTime representation of
+// midnight UTC on d, such as "20240301000000Z", in the form required by DER.
+//
+func (d Date) FormatGeneralizedTime() string {
+	return d.Format("20060102") + "000000Z"
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
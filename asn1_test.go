@@ -0,0 +1,89 @@
+package epochdate
+
+import (
+	"encoding/asn1"
+	"testing"
+	"time"
+)
+
+func TestParseUTCTime(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"240301120000Z", "2024-03-01"},
+		{"2403011200Z", "2024-03-01"},
+		{"491231235959Z", "2049-12-31"},
+		{"700101000000Z", "1970-01-01"},
+		{"2403010100+0200", "2024-03-01"},
+		{"240229235959-0800", "2024-02-29"},
+		{"", ""},
+		{"24030112", ""},
+		{"240230120000Z", ""},
+		{"500101000000Z", ""}, // 1950 is not representable
+	}
+	for _, tt := range tests {
+		d, err := ParseUTCTime(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseUTCTime(%q) = %v, want error", tt.in, d)
+			}
+			continue
+		}
+		if err != nil || d.String() != tt.want {
+			t.Errorf("ParseUTCTime(%q) = %v, %v; want %s", tt.in, d, err, tt.want)
+		}
+	}
+}
+
+func TestParseGeneralizedTime(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"20240301120000Z", "2024-03-01"},
+		{"20240301120000.123Z", "2024-03-01"},
+		{"202403011200Z", "2024-03-01"},
+		{"2024030112Z", "2024-03-01"},
+		{"21490606235959+1400", "2149-06-06"},
+		{"20240301", ""},
+		{"19691231235959Z", ""},
+	}
+	for _, tt := range tests {
+		d, err := ParseGeneralizedTime(tt.in)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("ParseGeneralizedTime(%q) = %v, want error", tt.in, d)
+			}
+			continue
+		}
+		if err != nil || d.String() != tt.want {
+			t.Errorf("ParseGeneralizedTime(%q) = %v, %v; want %s", tt.in, d, err, tt.want)
+		}
+	}
+}
+
+func TestFormatASN1(t *testing.T) {
+	d := MustParseRFC("2024-03-01")
+	if s, err := d.FormatUTCTime(); err != nil || s != "240301000000Z" {
+		t.Errorf("FormatUTCTime() = %q, %v", s, err)
+	}
+	if s := d.FormatGeneralizedTime(); s != "20240301000000Z" {
+		t.Errorf("FormatGeneralizedTime() = %q", s)
+	}
+	if s, err := MustParseRFC("2050-01-01").FormatUTCTime(); err == nil {
+		t.Errorf("FormatUTCTime() of 2050 = %q, want error", s)
+	}
+
+	// The formatted values must be accepted by encoding/asn1.
+	var tm time.Time
+	s, _ := d.FormatUTCTime()
+	b, _ := asn1.Marshal(asn1.RawValue{Tag: asn1.TagUTCTime, Bytes: []byte(s)})
+	if _, err := asn1.Unmarshal(b, &tm); err != nil || !tm.Equal(d.UTC()) {
+		t.Errorf("asn1.Unmarshal(UTCTime) = %v, %v", tm, err)
+	}
+	b, _ = asn1.Marshal(asn1.RawValue{Tag: asn1.TagGeneralizedTime, Bytes: []byte(d.FormatGeneralizedTime())})
+	if _, err := asn1.Unmarshal(b, &tm); err != nil || !tm.Equal(d.UTC()) {
+		t.Errorf("asn1.Unmarshal(GeneralizedTime) = %v, %v", tm, err)
+	}
+}
@@ -0,0 +1,56 @@
+package epochdate
+
+import (
+	"context"
+	"time"
+)
+
+// asOfKey is the context key for the as-of date.
+type asOfKey struct{}
+
+// WithAsOf returns a copy of ctx carrying d as the as-of date, which the
+// *Context variants of Today use in place of the current date. It allows
+// backtesting, replay, and simulations, such as of month-end processing, to
+// override the current date throughout a call tree, without affecting
+// concurrent requests as replacing DefaultClock would.
+//
+func WithAsOf(ctx context.Context, d Date) context.Context {
+	return context.WithValue(ctx, asOfKey{}, d)
+}
+
+// AsOf returns the as-of date carried by ctx, and whether there is one.
+func AsOf(ctx context.Context) (Date, bool) {
+	d, ok := ctx.Value(asOfKey{}).(Date)
+	return d, ok
+}
+
+// TodayContext returns the as-of date carried by ctx, if any, or else the
+// result of Today.
+//
+func TodayContext(ctx context.Context) Date {
+	if d, ok := AsOf(ctx); ok {
+		return d
+	}
+	return Today()
+}
+
+// TodayUTCContext returns the as-of date carried by ctx, if any, or else the
+// result of TodayUTC.
+//
+func TodayUTCContext(ctx context.Context) Date {
+	if d, ok := AsOf(ctx); ok {
+		return d
+	}
+	return TodayUTC()
+}
+
+// TodayInContext returns the as-of date carried by ctx, if any, or else the
+// result of TodayIn(loc). The as-of date is a date, rather than an instant,
+// so it is not adjusted for loc.
+//
+func TodayInContext(ctx context.Context, loc *time.Location) Date {
+	if d, ok := AsOf(ctx); ok {
+		return d
+	}
+	return TodayIn(loc)
+}
@@ -0,0 +1,41 @@
+package epochdate
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestAsOf(t *testing.T) {
+	defer func() { DefaultClock = SystemClock }()
+	DefaultClock = fixedClock(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+	now := MustParseRFC("2024-03-01")
+	monthEnd := MustParseRFC("2024-01-31")
+
+	ctx := context.Background()
+	if d, ok := AsOf(ctx); ok {
+		t.Errorf("AsOf(Background) = %v, true", d)
+	}
+	if d := TodayUTCContext(ctx); d != now {
+		t.Errorf("TodayUTCContext without as-of date = %v; want %v", d, now)
+	}
+	if d := TodayInContext(ctx, time.UTC); d != now {
+		t.Errorf("TodayInContext without as-of date = %v; want %v", d, now)
+	}
+
+	ctx = WithAsOf(ctx, monthEnd)
+	if d, ok := AsOf(ctx); !ok || d != monthEnd {
+		t.Errorf("AsOf = %v, %v; want %v, true", d, ok, monthEnd)
+	}
+	for name, fn := range map[string]func(context.Context) Date{
+		"TodayContext":    TodayContext,
+		"TodayUTCContext": TodayUTCContext,
+		"TodayInContext": func(ctx context.Context) Date {
+			return TodayInContext(ctx, time.FixedZone("UTC+14", 14*3600))
+		},
+	} {
+		if d := fn(ctx); d != monthEnd {
+			t.Errorf("%s = %v; want %v", name, d, monthEnd)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package epochdate
+
+import "sync/atomic"
+
+// AtomicDate is a Date which may be loaded and stored atomically, such as a
+// service's current business date, which is read by many goroutines and
+// rolled forward by one. The zero value holds 1970-01-01. An AtomicDate must
+// not be copied after first use.
+//
+type AtomicDate struct {
+	v uint32
+}
+
+// Load atomically loads the date.
+func (a *AtomicDate) Load() Date {
+	return Date(atomic.LoadUint32(&a.v))
+}
+
+// Store atomically stores d.
+func (a *AtomicDate) Store(d Date) {
+	atomic.StoreUint32(&a.v, uint32(d))
+}
+
+// Swap atomically stores d, and returns the previous date.
+func (a *AtomicDate) Swap(d Date) (old Date) {
+	return Date(atomic.SwapUint32(&a.v, uint32(d)))
+}
+
+// CompareAndSwap atomically stores new if the date is old, and reports
+// whether it did so. It allows a date to be advanced exactly once, even if
+// several goroutines attempt it:
+//
+//	if current.CompareAndSwap(d, d+1) {
+//		// this goroutine rolled the date; run end-of-day processing
+//	}
+//
+func (a *AtomicDate) CompareAndSwap(old, new Date) (swapped bool) {
+	return atomic.CompareAndSwapUint32(&a.v, uint32(old), uint32(new))
+}
@@ -0,0 +1,50 @@
+package epochdate
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicDate(t *testing.T) {
+	var a AtomicDate
+	if d := a.Load(); d != 0 {
+		t.Errorf("zero value Load() = %v", d)
+	}
+	a.Store(maxDate)
+	if d := a.Load(); d != maxDate {
+		t.Errorf("Load() = %v; want %v", d, Date(maxDate))
+	}
+	if old := a.Swap(100); old != maxDate || a.Load() != 100 {
+		t.Errorf("Swap(100) = %v, then Load() = %v", old, a.Load())
+	}
+	if a.CompareAndSwap(99, 200) || a.Load() != 100 {
+		t.Errorf("CompareAndSwap with wrong old value swapped")
+	}
+	if !a.CompareAndSwap(100, 200) || a.Load() != 200 {
+		t.Errorf("CompareAndSwap with correct old value did not swap")
+	}
+}
+
+func TestAtomicDate_concurrent(t *testing.T) {
+	var a AtomicDate
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	rolled := 0
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := Date(0); d < 1000; d++ {
+				if a.CompareAndSwap(d, d+1) {
+					mu.Lock()
+					rolled++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if a.Load() != 1000 || rolled != 1000 {
+		t.Errorf("after concurrent rolls, Load() = %v, rolled = %d; want 1000, 1000", a.Load(), rolled)
+	}
+}
@@ -0,0 +1,160 @@
+package benchmarks
+
+import (
+	"testing"
+	"time"
+
+	"cloud.google.com/go/civil"
+	"github.com/xtgo/epochdate"
+)
+
+const rfc3339 = "2024-03-01"
+
+var (
+	sinkDate  epochdate.Date
+	sinkTime  time.Time
+	sinkCivil civil.Date
+	sinkStr   string
+	sinkBool  bool
+	sinkInt   int
+)
+
+// dates returns n consecutive dates, in each of the compared
+// representations.
+//
+func dates(n int) ([]epochdate.Date, []time.Time, []civil.Date) {
+	ed := make([]epochdate.Date, n)
+	tt := make([]time.Time, n)
+	cd := make([]civil.Date, n)
+	start := epochdate.MustParseRFC(rfc3339)
+	for i := range ed {
+		ed[i] = start + epochdate.Date(i)
+		tt[i] = ed[i].UTC()
+		cd[i] = civil.DateOf(tt[i])
+	}
+	return ed, tt, cd
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.Run("epochdate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkDate, _ = epochdate.ParseRFC(rfc3339)
+		}
+	})
+
+	b.Run("time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkTime, _ = time.Parse(epochdate.RFC3339, rfc3339)
+		}
+	})
+
+	b.Run("civil", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkCivil, _ = civil.ParseDate(rfc3339)
+		}
+	})
+}
+
+func BenchmarkFormat(b *testing.B) {
+	ed, tt, cd := dates(1)
+
+	b.Run("epochdate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkStr = ed[0].String()
+		}
+	})
+
+	b.Run("time", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkStr = tt[0].Format(epochdate.RFC3339)
+		}
+	})
+
+	b.Run("civil", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			sinkStr = cd[0].String()
+		}
+	})
+}
+
+func BenchmarkCompare(b *testing.B) {
+	const n = 1024
+	ed, tt, cd := dates(n)
+
+	b.Run("epochdate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkBool = ed[i%n] < ed[(i+1)%n]
+		}
+	})
+
+	b.Run("time", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkBool = tt[i%n].Before(tt[(i+1)%n])
+		}
+	})
+
+	b.Run("civil", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sinkBool = cd[i%n].Before(cd[(i+1)%n])
+		}
+	})
+}
+
+func BenchmarkMapKey(b *testing.B) {
+	const n = 1024
+	ed, tt, cd := dates(n)
+
+	b.Run("epochdate", func(b *testing.B) {
+		m := make(map[epochdate.Date]int, n)
+		for i, d := range ed {
+			m[d] = i
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = m[ed[i%n]]
+		}
+	})
+
+	b.Run("time", func(b *testing.B) {
+		// time.Time values must not be used as map keys directly, since
+		// values for the same instant may differ in location or monotonic
+		// clock reading; keys are normalized with UTC and Round(0).
+		m := make(map[time.Time]int, n)
+		for i, t := range tt {
+			m[t.UTC().Round(0)] = i
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = m[tt[i%n].UTC().Round(0)]
+		}
+	})
+
+	b.Run("civil", func(b *testing.B) {
+		m := make(map[civil.Date]int, n)
+		for i, d := range cd {
+			m[d] = i
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = m[cd[i%n]]
+		}
+	})
+}
+
+// TestEquivalence guards the benchmarks against comparing
+// representations which do not agree.
+//
+func TestEquivalence(t *testing.T) {
+	ed, tt, cd := dates(400)
+	for i := range ed {
+		if s := ed[i].String(); s != tt[i].Format(epochdate.RFC3339) || s != cd[i].String() {
+			t.Errorf("representations of %s disagree: %s, %s", s, tt[i].Format(epochdate.RFC3339), cd[i])
+		}
+	}
+}
@@ -0,0 +1,12 @@
+// Package benchmarks compares the performance of epochdate against
+// time.Time and civil.Date for common workloads: parsing, formatting,
+// comparison, and use as map keys. It contains no code other than its
+// benchmarks, which may be run with:
+//
+//	cd benchmarks && go test -bench . -benchmem
+//
+// Comparing runs before and after a change with benchstat
+// (golang.org/x/perf/cmd/benchstat) catches performance regressions, and
+// keeps the efficiency claims in the epochdate documentation honest.
+//
+package benchmarks
@@ -0,0 +1,9 @@
+module github.com/xtgo/epochdate/benchmarks
+
+go 1.24.0
+
+require github.com/xtgo/epochdate v0.0.0
+
+require cloud.google.com/go v0.123.0
+
+replace github.com/xtgo/epochdate => ../
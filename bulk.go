@@ -0,0 +1,53 @@
+package epochdate
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// IndexedError reports a value of a batch which is not a valid Date, such
+// as a row of a bulk import.
+//
+type IndexedError struct {
+	Index int    // position of the value in the batch
+	Value string // the value, as text
+	Err   error  // the reason the value is invalid
+}
+
+func (e IndexedError) Error() string {
+	return fmt.Sprintf("epochdate: value %d (%q): %v", e.Index, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying error, such as ErrOutOfRange.
+func (e IndexedError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateSlice parses each of values according to layout, as by Parse, and
+// returns an error for every value which fails, in order, so that all the
+// invalid values of a batch can be reported at once. The result is nil if
+// every value is valid. As with Parse, out-of-range dates are errors unless
+// Clamp is set.
+//
+func ValidateSlice(values []string, layout string) []IndexedError {
+	var errs []IndexedError
+	for i, v := range values {
+		if _, err := Parse(layout, v); err != nil {
+			errs = append(errs, IndexedError{Index: i, Value: v, Err: err})
+		}
+	}
+	return errs
+}
+
+// ValidateUnixSlice is like ValidateSlice, except that values are Unix
+// timestamps, as accepted by NewFromUnix.
+//
+func ValidateUnixSlice(values []int64) []IndexedError {
+	var errs []IndexedError
+	for i, v := range values {
+		if _, err := NewFromUnix(v); err != nil {
+			errs = append(errs, IndexedError{Index: i, Value: strconv.FormatInt(v, 10), Err: err})
+		}
+	}
+	return errs
+}
@@ -0,0 +1,53 @@
+package epochdate
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateSlice(t *testing.T) {
+	defer func(c bool) { Clamp = c }(Clamp)
+	Clamp = false
+
+	values := []string{"2024-03-01", "2024-02-30", "", "2150-01-01", "1970-01-01"}
+	errs := ValidateSlice(values, RFC3339)
+	if len(errs) != 3 {
+		t.Fatalf("ValidateSlice returned %d errors: %v", len(errs), errs)
+	}
+	for i, want := range []int{1, 2, 3} {
+		if errs[i].Index != want || errs[i].Value != values[want] {
+			t.Errorf("error %d = %v; want index %d", i, errs[i], want)
+		}
+	}
+	if errs[2].Unwrap() != ErrOutOfRange {
+		t.Errorf("error for out-of-range value = %v; want ErrOutOfRange", errs[2].Err)
+	}
+	if got, want := errs[2].Error(), `epochdate: value 3 ("2150-01-01"): `+ErrOutOfRange.Error(); got != want {
+		t.Errorf("Error() = %q; want %q", got, want)
+	}
+
+	// Elements are errors themselves, so they may be returned and matched.
+	var err error = errs[2]
+	var ie IndexedError
+	if !errors.As(err, &ie) || ie.Index != 3 || !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("errors.As(%v) = %+v", err, ie)
+	}
+
+	if errs := ValidateSlice([]string{"2024-03-01"}, RFC3339); errs != nil {
+		t.Errorf("ValidateSlice of valid values = %v", errs)
+	}
+}
+
+func TestValidateUnixSlice(t *testing.T) {
+	defer func(c bool) { Clamp = c }(Clamp)
+	Clamp = false
+
+	errs := ValidateUnixSlice([]int64{0, -1, maxUnix, maxUnix + 1})
+	if len(errs) != 2 || errs[0].Index != 1 || errs[1].Index != 3 || errs[0].Value != "-1" {
+		t.Errorf("ValidateUnixSlice = %v", errs)
+	}
+	Clamp = true
+	if errs := ValidateUnixSlice([]int64{-1}); errs != nil {
+		t.Errorf("ValidateUnixSlice with Clamp = %v", errs)
+	}
+}
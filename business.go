@@ -0,0 +1,172 @@
+package epochdate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errInvalidWeekday = errors.New("epochdate: HolidayCalendar weekend day must be in range [Sunday,Saturday]")
+	errNoBusinessDays = errors.New("epochdate: HolidayCalendar weekend must not include every day of the week")
+)
+
+// SaturdaySunday is the conventional weekend, for use with HolidayCalendar.
+var SaturdaySunday = []time.Weekday{time.Saturday, time.Sunday}
+
+// HolidayCalendar determines which dates are business days: those which are
+// neither weekend days nor holidays. The zero value has no weekend days or
+// holidays, so every date is a business day.
+//
+type HolidayCalendar struct {
+	// Name identifies the calendar, such as "NYSE".
+	Name string
+
+	// Weekend lists the days of the week which are never business days.
+	Weekend []time.Weekday
+
+	// Holidays holds the non-weekend dates which are not business days.
+	// Holidays which fall on weekend days may be included, but have no
+	// additional effect.
+	Holidays DateSet
+}
+
+// Validate returns an error if the calendar's weekend includes invalid
+// weekdays, or every day of the week, in which case there are no business
+// days.
+//
+func (c HolidayCalendar) Validate() error {
+	var seen [7]bool
+	n := 0
+	for _, w := range c.Weekend {
+		if w < time.Sunday || w > time.Saturday {
+			return errInvalidWeekday
+		}
+		if !seen[w] {
+			seen[w] = true
+			n++
+		}
+	}
+	if n == len(seen) {
+		return errNoBusinessDays
+	}
+	return nil
+}
+
+// IsHoliday reports whether d is one of the calendar's holidays.
+func (c HolidayCalendar) IsHoliday(d Date) bool {
+	return c.Holidays.Contains(d)
+}
+
+// IsWeekend reports whether d falls on one of the calendar's weekend days.
+func (c HolidayCalendar) IsWeekend(d Date) bool {
+	w := d.UTC().Weekday()
+	for _, v := range c.Weekend {
+		if v == w {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday.
+func (c HolidayCalendar) IsBusinessDay(d Date) bool {
+	return IsBusinessDay(c, d)
+}
+
+// NextBusinessDay is equivalent to the package-level NextBusinessDay
+// function, using the receiver as the calendar.
+//
+func (c HolidayCalendar) NextBusinessDay(d Date) (Date, error) {
+	return NextBusinessDay(c, d)
+}
+
+// AddBusinessDays is equivalent to the package-level AddBusinessDays
+// function, using the receiver as the calendar.
+//
+func (c HolidayCalendar) AddBusinessDays(d Date, n int) (Date, error) {
+	return AddBusinessDays(c, d, n)
+}
+
+// CalendarProvider supplies the non-business days used by the business-day
+// functions. HolidayCalendar is an implementation backed by a DateSet, but
+// applications may implement CalendarProvider to consult a database or
+// service, or to mock a calendar in tests.
+//
+type CalendarProvider interface {
+	// IsHoliday reports whether d is a holiday.
+	IsHoliday(d Date) bool
+
+	// IsWeekend reports whether d falls on a weekend day.
+	IsWeekend(d Date) bool
+}
+
+// IsBusinessDay reports whether d is neither a weekend day nor a holiday,
+// according to cal.
+//
+func IsBusinessDay(cal CalendarProvider, d Date) bool {
+	return !cal.IsWeekend(d) && !cal.IsHoliday(d)
+}
+
+// NextBusinessDay returns the first business day after d, according to cal.
+// ErrOutOfRange is returned if there is no such representable date.
+//
+func NextBusinessDay(cal CalendarProvider, d Date) (Date, error) {
+	return AddBusinessDays(cal, d, 1)
+}
+
+// AddBusinessDays returns the date n business days after d, or before d if n
+// is negative, according to cal. Only the business days after (or before) d
+// are counted, so d itself need not be a business day, and adding zero
+// business days returns d. ErrOutOfRange is returned if the result is not
+// representable.
+//
+func AddBusinessDays(cal CalendarProvider, d Date, n int) (Date, error) {
+	for ; n > 0; d++ {
+		if d.IsMax() {
+			return 0, ErrOutOfRange
+		}
+		if IsBusinessDay(cal, d+1) {
+			n--
+		}
+	}
+	for ; n < 0; d-- {
+		if d.IsMin() {
+			return 0, ErrOutOfRange
+		}
+		if IsBusinessDay(cal, d-1) {
+			n++
+		}
+	}
+	return d, nil
+}
+
+// CountBusinessDays returns the number of business days in r, according to
+// cal.
+//
+func CountBusinessDays(cal CalendarProvider, r DateRange) int {
+	n := 0
+	for d := int(r.Start); d <= int(r.End); d++ {
+		if IsBusinessDay(cal, Date(d)) {
+			n++
+		}
+	}
+	return n
+}
+
+// BusinessDays returns the number of business days in ym, according to cal.
+// Only representable dates are counted, so the result is partial for
+// 2149-06, and zero for later months.
+//
+func (ym YearMonth) BusinessDays(cal CalendarProvider) int {
+	if ym.Validate() != nil {
+		return 0
+	}
+	return CountBusinessDays(cal, DateRange{Start: ym.StartDate(), End: ym.EndDate()})
+}
+
+// WorkdaysRemaining returns the number of business days from d through the
+// end of its month, inclusive, according to cal.
+//
+func WorkdaysRemaining(cal CalendarProvider, d Date) int {
+	return CountBusinessDays(cal, DateRange{Start: d, End: d.YearMonth().EndDate()})
+}
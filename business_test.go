@@ -0,0 +1,316 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+// testCalendar is a Saturday and Sunday calendar, with New Year's Day and
+// Christmas Day of 2024 as holidays.
+var _ CalendarProvider = HolidayCalendar{}
+
+var testCalendar = HolidayCalendar{
+	Name:     "test",
+	Weekend:  SaturdaySunday,
+	Holidays: NewDateSet(ClampFromDate(2024, 1, 1), ClampFromDate(2024, 12, 25)),
+}
+
+func TestHolidayCalendar_IsBusinessDay(t *testing.T) {
+	tests := []struct {
+		name  string
+		cal   HolidayCalendar
+		input Date
+		want  bool
+	}{
+		{
+			name:  "weekday",
+			cal:   testCalendar,
+			input: ClampFromDate(2024, 1, 2),
+			want:  true,
+		},
+		{
+			name:  "saturday",
+			cal:   testCalendar,
+			input: ClampFromDate(2024, 1, 6),
+			want:  false,
+		},
+		{
+			name:  "sunday",
+			cal:   testCalendar,
+			input: ClampFromDate(2024, 1, 7),
+			want:  false,
+		},
+		{
+			name:  "holiday",
+			cal:   testCalendar,
+			input: ClampFromDate(2024, 12, 25),
+			want:  false,
+		},
+		{
+			name:  "friday_saturday_weekend",
+			cal:   HolidayCalendar{Weekend: []time.Weekday{time.Friday, time.Saturday}},
+			input: ClampFromDate(2024, 1, 7),
+			want:  true,
+		},
+		{
+			name:  "zero_calendar",
+			cal:   HolidayCalendar{},
+			input: ClampFromDate(2024, 1, 6),
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cal.IsBusinessDay(tt.input)
+			if got != tt.want {
+				t.Errorf("IsBusinessDay(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendar_AddBusinessDays(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   Date
+		n       int
+		want    Date
+		wantErr bool
+	}{
+		{
+			name:  "zero",
+			input: ClampFromDate(2024, 1, 6),
+			n:     0,
+			want:  ClampFromDate(2024, 1, 6),
+		},
+		{
+			name:  "over_weekend",
+			input: ClampFromDate(2024, 1, 5),
+			n:     1,
+			want:  ClampFromDate(2024, 1, 8),
+		},
+		{
+			name:  "from_weekend",
+			input: ClampFromDate(2024, 1, 6),
+			n:     1,
+			want:  ClampFromDate(2024, 1, 8),
+		},
+		{
+			name:  "over_holiday_and_weekend",
+			input: ClampFromDate(2023, 12, 29),
+			n:     1,
+			want:  ClampFromDate(2024, 1, 2),
+		},
+		{
+			name:  "two_weeks",
+			input: ClampFromDate(2024, 1, 2),
+			n:     10,
+			want:  ClampFromDate(2024, 1, 16),
+		},
+		{
+			name:  "backward",
+			input: ClampFromDate(2024, 1, 2),
+			n:     -1,
+			want:  ClampFromDate(2023, 12, 29),
+		},
+		{
+			name:    "overflow",
+			input:   maxDate - 1,
+			n:       2,
+			wantErr: true,
+		},
+		{
+			name:    "underflow",
+			input:   0,
+			n:       -1,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testCalendar.AddBusinessDays(tt.input, tt.n)
+			switch {
+			case tt.wantErr && err != ErrOutOfRange:
+				t.Errorf("AddBusinessDays(%q, %d) = %v [err], want %v", tt.input, tt.n, err, ErrOutOfRange)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("AddBusinessDays(%q, %d) = %q [err], want nil", tt.input, tt.n, err)
+
+			case got != tt.want:
+				t.Errorf("AddBusinessDays(%q, %d) = %q, want %q", tt.input, tt.n, got, tt.want)
+			}
+
+			if tt.n != 1 || tt.wantErr {
+				return
+			}
+
+			got, err = testCalendar.NextBusinessDay(tt.input)
+			if err != nil || got != tt.want {
+				t.Errorf("NextBusinessDay(%q) = %q, %v, want %q, nil", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestHolidayCalendar_Validate(t *testing.T) {
+	everyDay := []time.Weekday{0, 1, 2, 3, 4, 5, 6}
+
+	tests := []struct {
+		name    string
+		cal     HolidayCalendar
+		wantErr bool
+	}{
+		{
+			name: "zero",
+			cal:  HolidayCalendar{},
+		},
+		{
+			name: "test",
+			cal:  testCalendar,
+		},
+		{
+			name: "duplicate_weekend_days",
+			cal:  HolidayCalendar{Weekend: append(everyDay[1:], everyDay[1:]...)},
+		},
+		{
+			name:    "invalid_weekday",
+			cal:     HolidayCalendar{Weekend: []time.Weekday{7}},
+			wantErr: true,
+		},
+		{
+			name:    "no_business_days",
+			cal:     HolidayCalendar{Weekend: everyDay},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cal.Validate()
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("Validate() = nil, want error")
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("Validate() = %q, want nil", err)
+			}
+		})
+	}
+}
+
+// monthStarts is a CalendarProvider under which the first day of every
+// month is a holiday, and the weekend is Saturday and Sunday.
+type monthStarts struct{}
+
+func (monthStarts) IsHoliday(d Date) bool {
+	_, _, day := d.Date()
+	return day == 1
+}
+
+func (monthStarts) IsWeekend(d Date) bool {
+	w := d.UTC().Weekday()
+	return w == time.Saturday || w == time.Sunday
+}
+
+func TestCalendarProvider(t *testing.T) {
+	var cal CalendarProvider = monthStarts{}
+
+	tests := []struct {
+		name  string
+		input Date
+		n     int
+		want  Date
+	}{
+		{
+			name:  "next",
+			input: ClampFromDate(2024, 3, 27),
+			n:     1,
+			want:  ClampFromDate(2024, 3, 28),
+		},
+		{
+			name:  "over_weekend_and_holiday",
+			input: ClampFromDate(2024, 3, 29),
+			n:     1,
+			want:  ClampFromDate(2024, 4, 2),
+		},
+		{
+			name:  "several",
+			input: ClampFromDate(2024, 3, 28),
+			n:     3,
+			want:  ClampFromDate(2024, 4, 3),
+		},
+		{
+			name:  "backward",
+			input: ClampFromDate(2024, 4, 2),
+			n:     -1,
+			want:  ClampFromDate(2024, 3, 29),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AddBusinessDays(cal, tt.input, tt.n)
+			if err != nil || got != tt.want {
+				t.Errorf("AddBusinessDays(%q, %d) = %q, %v, want %q, nil", tt.input, tt.n, got, err, tt.want)
+			}
+
+			if !IsBusinessDay(cal, got) {
+				t.Errorf("IsBusinessDay(%q) = false, want true", got)
+			}
+
+			if tt.n != 1 {
+				return
+			}
+
+			got, err = NextBusinessDay(cal, tt.input)
+			if err != nil || got != tt.want {
+				t.Errorf("NextBusinessDay(%q) = %q, %v, want %q, nil", tt.input, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestYearMonth_BusinessDays(t *testing.T) {
+	ym := func(s string) YearMonth { return MustParseRFC(s).YearMonth() }
+	tests := []struct {
+		ym   YearMonth
+		cal  CalendarProvider
+		want int
+	}{
+		{ym("2024-01-01"), testCalendar, 22},
+		{ym("2024-03-01"), testCalendar, 21},
+		{ym("2024-12-01"), testCalendar, 21},
+		{ym("2024-12-01"), HolidayCalendar{}, 31},
+		{ym("2149-06-01"), testCalendar, 5},
+		{ym("2149-06-01") + 1, testCalendar, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.ym.BusinessDays(tt.cal); got != tt.want {
+			t.Errorf("%v.BusinessDays() = %d, want %d", tt.ym, got, tt.want)
+		}
+	}
+}
+
+func TestWorkdaysRemaining(t *testing.T) {
+	tests := []struct {
+		from string
+		want int
+	}{
+		{"2024-12-01", 21},
+		{"2024-12-20", 7},
+		{"2024-12-21", 6},
+		{"2024-12-31", 1},
+		{"2149-06-06", 1},
+	}
+	for _, tt := range tests {
+		if got := WorkdaysRemaining(testCalendar, MustParseRFC(tt.from)); got != tt.want {
+			t.Errorf("WorkdaysRemaining(%s) = %d, want %d", tt.from, got, tt.want)
+		}
+	}
+
+	if got := CountBusinessDays(testCalendar, DateRange{Start: MustParseRFC("2024-01-02"), End: MustParseRFC("2024-01-01")}); got != 0 {
+		t.Errorf("CountBusinessDays(empty) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,108 @@
+// Package cdcdate converts the dates in change events produced by Debezium
+// and other Kafka Connect sources. Both the io.debezium.time.Date and the
+// org.apache.kafka.connect.data.Date logical types encode a date as an
+// int32 number of days since 1970-01-01, which appears in JSON-encoded
+// events as a number, and in Avro-encoded events as an int.
+//
+// Payload structs may declare such fields with the Date type:
+//
+//	type Order struct {
+//		ID      int64        `json:"id"`
+//		Ordered cdcdate.Date `json:"ordered"`
+//	}
+//
+// while consumers handling events generically may use Convert with the field
+// schema name.
+//
+package cdcdate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/xtgo/epochdate"
+)
+
+// Schema names of the logical types holding dates as days since 1970-01-01.
+const (
+	DebeziumDate = "io.debezium.time.Date"
+	ConnectDate  = "org.apache.kafka.connect.data.Date"
+)
+
+// Date is an epochdate.Date which is encoded in JSON as a number of days
+// since 1970-01-01, as in Kafka Connect payloads. A JSON null leaves it
+// unchanged.
+//
+type Date struct {
+	epochdate.Date
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(d.Int32()), 10), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	n, err := strconv.ParseInt(string(data), 10, 32)
+	if err != nil {
+		return fmt.Errorf("cdcdate: invalid day number %s", data)
+	}
+	v, err := epochdate.NewFromInt32(int32(n))
+	if err != nil {
+		return err
+	}
+	d.Date = v
+	return nil
+}
+
+// Convert returns the Date held by the value of a field with the given
+// schema name, which must be DebeziumDate or ConnectDate. The value may be
+// any of the types produced by common decoders for an int32: an int32,
+// int64, or int, as from Avro decoders, or a float64 or json.Number, as from
+// encoding/json.
+//
+func Convert(schemaName string, value interface{}) (epochdate.Date, error) {
+	if schemaName != DebeziumDate && schemaName != ConnectDate {
+		return 0, fmt.Errorf("cdcdate: schema %q is not a date", schemaName)
+	}
+
+	var days int64
+	switch v := value.(type) {
+	case int32:
+		days = int64(v)
+
+	case int64:
+		days = v
+
+	case int:
+		days = int64(v)
+
+	case float64:
+		if v != math.Trunc(v) || math.Abs(v) > math.MaxInt32 {
+			return 0, fmt.Errorf("cdcdate: invalid day number %v", v)
+		}
+		days = int64(v)
+
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("cdcdate: invalid day number %s", v)
+		}
+		days = n
+
+	default:
+		return 0, fmt.Errorf("cdcdate: cannot convert %T to a date", value)
+	}
+
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return 0, epochdate.ErrOutOfRange
+	}
+	return epochdate.NewFromInt32(int32(days))
+}
@@ -0,0 +1,62 @@
+package cdcdate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/xtgo/epochdate"
+)
+
+func TestJSON(t *testing.T) {
+	var order struct {
+		Ordered Date `json:"ordered"`
+		Shipped Date `json:"shipped"`
+	}
+	if err := json.Unmarshal([]byte(`{"ordered":19783,"shipped":null}`), &order); err != nil {
+		t.Fatal(err)
+	}
+	if want := epochdate.MustParseRFC("2024-03-01"); order.Ordered.Date != want || order.Shipped.Date != 0 {
+		t.Errorf("decoded %v, %v; want %v, 1970-01-01", order.Ordered, order.Shipped, want)
+	}
+
+	b, err := json.Marshal(order)
+	if want := `{"ordered":19783,"shipped":0}`; err != nil || string(b) != want {
+		t.Errorf("json.Marshal = %s, %v; want %s", b, err, want)
+	}
+
+	for _, in := range []string{`"2024-03-01"`, `1.5`, `-1`, `65536`, `4294967296`} {
+		var d Date
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Errorf("Unmarshal(%s) = %v, want error", in, d)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	for _, v := range []interface{}{int32(19783), int64(19783), 19783, float64(19783), json.Number("19783")} {
+		for _, name := range []string{DebeziumDate, ConnectDate} {
+			if d, err := Convert(name, v); err != nil || d != want {
+				t.Errorf("Convert(%s, %#v) = %v, %v; want %v", name, v, d, err, want)
+			}
+		}
+	}
+
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"io.debezium.time.Timestamp", int64(19783)},
+		{DebeziumDate, "19783"},
+		{DebeziumDate, 1.5},
+		{DebeziumDate, json.Number("1e3")},
+		{DebeziumDate, int64(-1)},
+		{DebeziumDate, int64(1 << 40)},
+		{DebeziumDate, nil},
+	}
+	for _, tt := range tests {
+		if d, err := Convert(tt.name, tt.value); err == nil {
+			t.Errorf("Convert(%s, %#v) = %v, want error", tt.name, tt.value, d)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package epochdate
+
+import "time"
+
+// civil returns the year, month, and day of the date n days after
+// 1970-01-01, using integer arithmetic rather than time.Time. The date must
+// not precede 0000-03-01.
+//
+// The algorithm is that of Howard Hinnant's days_from_civil and
+// civil_from_days, which treat years as beginning on March 1, so that leap
+// days fall at the ends of years.
+//
+func civil(n int) (year int, month time.Month, dom int) {
+	z := n + 719468 // days from 0000-03-01 until 1970-01-01
+	era := z / 146097
+	doe := z - era*146097                                  // [0, 146096]
+	yoe := (doe - doe/1460 + doe/36524 - doe/146096) / 365 // [0, 399]
+	doy := doe - (365*yoe + yoe/4 - yoe/100)               // [0, 365]
+	mp := (5*doy + 2) / 153                                // [0, 11], from March
+	dom = doy - (153*mp+2)/5 + 1
+	year = yoe + era*400
+	if mp < 10 {
+		month = time.Month(mp + 3)
+	} else {
+		month = time.Month(mp - 9)
+		year++
+	}
+	return year, month, dom
+}
+
+// yearStart returns the number of days from 1970-01-01 until January 1 of
+// the given year.
+//
+func yearStart(year int) int {
+	return 365*(year-minYear) + leapYearsBefore(year) - leapYearsBefore(minYear)
+}
+
+// yearDay returns the day of the year of the date n days after 1970-01-01,
+// in the range [1,365] in common years and [1,366] in leap years, and its
+// year.
+//
+func yearDay(n int) (year, yday int) {
+	year, _, _ = civil(n)
+	return year, n - yearStart(year) + 1
+}
+
+// YearDay returns the day of the year of d, in the range [1,365] in common
+// years and [1,366] in leap years, as time.Time.YearDay does.
+//
+func (d Date) YearDay() int {
+	_, yday := yearDay(int(d))
+	return yday
+}
+
+// civilDays returns the number of days from 1970-01-01 until the given
+// date, which must be valid, and not precede 0000-03-01. It is the inverse
+// of civil.
+//
+func civilDays(year, month, dom int) int {
+	if month <= 2 {
+		year--
+	}
+	era := year / 400
+	yoe := year - era*400                     // [0, 399]
+	doy := (153*((month+9)%12)+2)/5 + dom - 1 // [0, 365]
+	doe := yoe*365 + yoe/4 - yoe/100 + doy    // [0, 146096]
+	return era*146097 + doe - 719468
+}
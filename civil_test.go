@@ -0,0 +1,28 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_calendarFields(t *testing.T) {
+	for n := 0; n <= maxDate; n++ {
+		d := Date(n)
+		tm := time.Unix(int64(n)*day, 0).UTC()
+
+		year, month, dom := d.Date()
+		if wy, wm, wd := tm.Date(); year != wy || month != wm || dom != wd {
+			t.Fatalf("%d.Date() = %d, %v, %d; want %d, %v, %d", n, year, month, dom, wy, wm, wd)
+		}
+		if got, want := d.Weekday(), tm.Weekday(); got != want {
+			t.Fatalf("%v.Weekday() = %v; want %v", d, got, want)
+		}
+		if got, want := d.YearDay(), tm.YearDay(); got != want {
+			t.Fatalf("%v.YearDay() = %d; want %d", d, got, want)
+		}
+		year, week := d.ISOWeek()
+		if wy, ww := tm.ISOWeek(); year != wy || week != ww {
+			t.Fatalf("%v.ISOWeek() = %d, %d; want %d, %d", d, year, week, wy, ww)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package epochdate
+
+import "time"
+
+// Clock provides the current instant to Today and related functions.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts an ordinary function, such as time.Now, to the Clock
+// interface.
+//
+type ClockFunc func() time.Time
+
+// Now returns fn().
+func (fn ClockFunc) Now() time.Time {
+	return fn()
+}
+
+// SystemClock is a Clock backed by time.Now.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// DefaultClock is the Clock consulted by Today, TodayUTC, and TodayIn. Tests
+// and simulations may replace it to freeze or advance the current date, and
+// should restore it to SystemClock afterward. As with Clamp, DefaultClock
+// must not be modified while other goroutines may be reading it.
+//
+var DefaultClock = SystemClock
+
+// TodayFrom returns the date of c.Now(), relative to the location of the
+// returned time value. If that date does not fall within the representable
+// range, then the zero value will be returned (1970-01-01).
+//
+func TodayFrom(c Clock) Date {
+	date, _ := NewFromTime(c.Now())
+	return date
+}
+
+// Until returns the number of days from today, according to c, until the
+// receiver. The result is negative if the receiver is in the past. See
+// TodayFrom for how today is determined.
+//
+func (d Date) Until(c Clock) int {
+	return TodayFrom(c).DaysUntil(d)
+}
+
+// Since returns the number of days elapsed since the receiver, until today
+// according to c. The result is negative if the receiver is in the future.
+// See TodayFrom for how today is determined.
+//
+func (d Date) Since(c Clock) int {
+	return d.DaysUntil(TodayFrom(c))
+}
@@ -0,0 +1,184 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func fixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time { return t })
+}
+
+func TestTodayFrom(t *testing.T) {
+	tests := []struct {
+		name string
+		now  time.Time
+		want Date
+	}{
+		{
+			name: "utc",
+			now:  time.Date(2020, 2, 29, 12, 0, 0, 0, time.UTC),
+			want: ClampFromDate(2020, 2, 29),
+		},
+		{
+			name: "west_of_utc",
+			now:  time.Date(2020, 2, 29, 23, 0, 0, 0, time.FixedZone("UTC-5", -5*60*60)),
+			want: ClampFromDate(2020, 2, 29),
+		},
+		{
+			name: "underflow",
+			now:  time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC),
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TodayFrom(fixedClock(tt.now))
+			if got != tt.want {
+				t.Errorf("TodayFrom(%q) = %q, want %q", tt.now, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultClock(t *testing.T) {
+	defer func() { DefaultClock = SystemClock }()
+
+	// 23:30 on Feb 28 in UTC-1 is already Feb 29 in UTC.
+	loc := time.FixedZone("UTC-1", -60*60)
+	DefaultClock = fixedClock(time.Date(2020, 2, 28, 23, 30, 0, 0, loc))
+
+	if got, want := TodayUTC(), ClampFromDate(2020, 2, 29); got != want {
+		t.Errorf("TodayUTC() = %q, want %q", got, want)
+	}
+
+	if got, want := TodayIn(loc), ClampFromDate(2020, 2, 28); got != want {
+		t.Errorf("TodayIn(%q) = %q, want %q", loc, got, want)
+	}
+
+	if got, want := Today(), ClampFromDate(DefaultClock.Now().Local().Date()); got != want {
+		t.Errorf("Today() = %q, want %q", got, want)
+	}
+}
+
+func TestDate_Until(t *testing.T) {
+	clock := fixedClock(time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name  string
+		input Date
+		want  int
+	}{
+		{
+			name:  "today",
+			input: ClampFromDate(2020, 3, 1),
+			want:  0,
+		},
+		{
+			name:  "tomorrow",
+			input: ClampFromDate(2020, 3, 2),
+			want:  1,
+		},
+		{
+			name:  "leap_day",
+			input: ClampFromDate(2020, 2, 29),
+			want:  -1,
+		},
+		{
+			name:  "next_year",
+			input: ClampFromDate(2021, 3, 1),
+			want:  365,
+		},
+		{
+			name:  "min",
+			input: 0,
+			want:  -18322,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.input.Until(clock); got != tt.want {
+				t.Errorf("%q.Until(%q) = %d, want %d", tt.input, clock.Now(), got, tt.want)
+			}
+
+			if got := tt.input.Since(clock); got != -tt.want {
+				t.Errorf("%q.Since(%q) = %d, want %d", tt.input, clock.Now(), got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestTodayErr(t *testing.T) {
+	defer func() { DefaultClock = SystemClock }()
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		want    Date
+		wantErr bool
+	}{
+		{
+			name: "in_range",
+			now:  time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC),
+			want: ClampFromDate(2020, 3, 1),
+		},
+		{
+			name:    "underflow",
+			now:     time.Date(1969, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+		{
+			name:    "overflow",
+			now:     time.Date(2150, 1, 1, 12, 0, 0, 0, time.UTC),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			DefaultClock = fixedClock(tt.now)
+
+			got, err := TodayUTCErr()
+			switch {
+			case tt.wantErr && err != ErrOutOfRange:
+				t.Errorf("TodayUTCErr() = %v [err], want %v", err, ErrOutOfRange)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("TodayUTCErr() = %q [err], want nil", err)
+
+			case got != tt.want:
+				t.Errorf("TodayUTCErr() = %q, want %q", got, tt.want)
+			}
+
+			// the local date is within a day of the UTC date.
+			_, err = TodayErr()
+			if tt.wantErr && err == nil {
+				t.Errorf("TodayErr() = nil [err], want error")
+			}
+		})
+	}
+}
+
+func TestTodayErr_clamp(t *testing.T) {
+	defer func() { DefaultClock = SystemClock }()
+	defer func(c bool) { Clamp = c }(Clamp)
+	Clamp = true
+
+	DefaultClock = ClockFunc(func() time.Time {
+		return time.Date(2200, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+	if got, err := TodayUTCErr(); err != ErrOutOfRange {
+		t.Errorf("TodayUTCErr() = %v, %v; want %v", got, err, ErrOutOfRange)
+	}
+	if got, err := TodayErr(); err != ErrOutOfRange {
+		t.Errorf("TodayErr() = %v, %v; want %v", got, err, ErrOutOfRange)
+	}
+	if got := TodayUTC(); got != maxDate {
+		t.Errorf("TodayUTC() = %v, want %v", got, Date(maxDate))
+	}
+	if got := Today(); got != maxDate {
+		t.Errorf("Today() = %v, want %v", got, Date(maxDate))
+	}
+}
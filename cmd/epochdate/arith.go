@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+var errAmount = errors.New("amount must be a sequence of integers with units y, m, w, or d, such as 1y2m or 45d")
+
+// amount is a calendar offset, as accepted by time.Time.AddDate.
+type amount struct {
+	years, months, days int
+}
+
+// parseAmount parses a sequence of signed integers, each followed by one of
+// the units y (years), m (months), w (weeks), or d (days), such as "1y-3d".
+//
+func parseAmount(s string) (amount, error) {
+	var a amount
+	if s == "" {
+		return a, errAmount
+	}
+	for s != "" {
+		i := 0
+		if s[0] == '+' || s[0] == '-' {
+			i++
+		}
+		for i < len(s) && '0' <= s[i] && s[i] <= '9' {
+			i++
+		}
+		if i == len(s) {
+			return a, errAmount
+		}
+		n, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return a, errAmount
+		}
+		switch s[i] {
+		case 'y':
+			a.years += n
+		case 'm':
+			a.months += n
+		case 'w':
+			a.days += 7 * n
+		case 'd':
+			a.days += n
+		default:
+			return a, errAmount
+		}
+		s = s[i+1:]
+	}
+	return a, nil
+}
+
+func (a amount) times(n int) amount {
+	return amount{n * a.years, n * a.months, n * a.days}
+}
+
+// addAmount adds a to d, normalizing month overflow as time.Time.AddDate
+// does, so that 2024-01-31 plus 1m is 2024-03-02.
+//
+func addAmount(d epochdate.Date, a amount) (epochdate.Date, error) {
+	return epochdate.NewFromTime(d.UTC().AddDate(a.years, a.months, a.days))
+}
+
+// parseDate parses s in any form accepted by the root command, with unix
+// timestamps interpreted relative to UTC.
+//
+func parseDate(s string) (epochdate.Date, error) {
+	return detectForm(s).parse(s, time.UTC)
+}
+
+func runAdd(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return runOffset("add", 1, args, stdout, stderr)
+}
+
+func runSub(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return runOffset("sub", -1, args, stdout, stderr)
+}
+
+// runOffset implements the add and sub commands, adding the amount given in
+// args, multiplied by sign, to the date given in args.
+//
+func runOffset(name string, sign int, args []string, stdout, stderr io.Writer) int {
+	if len(args) != 2 {
+		fmt.Fprintf(stderr, "usage: epochdate %s date amount\n", name)
+		return 2
+	}
+	d, err := parseDate(args[0])
+	if err != nil {
+		fmt.Fprintf(stderr, "epochdate: %s: %v\n", args[0], err)
+		return 1
+	}
+	a, err := parseAmount(args[1])
+	if err != nil {
+		fmt.Fprintf(stderr, "epochdate: %s: %v\n", args[1], err)
+		return 1
+	}
+	d, err = addAmount(d, a.times(sign))
+	if err != nil {
+		fmt.Fprintf(stderr, "epochdate: %v\n", err)
+		return 1
+	}
+	fmt.Fprintln(stdout, d)
+	return 0
+}
+
+func runDiff(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) != 2 {
+		fmt.Fprintln(stderr, "usage: epochdate diff from to")
+		return 2
+	}
+	var dates [2]epochdate.Date
+	for i, s := range args {
+		d, err := parseDate(s)
+		if err != nil {
+			fmt.Fprintf(stderr, "epochdate: %s: %v\n", s, err)
+			return 1
+		}
+		dates[i] = d
+	}
+	fmt.Fprintln(stdout, dates[0].DaysUntil(dates[1]))
+	return 0
+}
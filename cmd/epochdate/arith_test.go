@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    amount
+		wantErr bool
+	}{
+		{input: "45d", want: amount{days: 45}},
+		{input: "2w", want: amount{days: 14}},
+		{input: "1y2m3d", want: amount{1, 2, 3}},
+		{input: "-1m+3d", want: amount{months: -1, days: 3}},
+		{input: "1w1d", want: amount{days: 8}},
+		{input: "", wantErr: true},
+		{input: "45", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "3h", wantErr: true},
+		{input: "1y-", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseAmount(tt.input)
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("parseAmount(%q) = nil [err], want error", tt.input)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("parseAmount(%q) = %q [err], want nil", tt.input, err)
+
+			case !tt.wantErr && got != tt.want:
+				t.Errorf("parseAmount(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_arith(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "add_days",
+			args: []string{"add", "2024-03-01", "45d"},
+			want: "2024-04-15\n",
+		},
+		{
+			name: "add_month_overflow",
+			args: []string{"add", "2024-01-31", "1m"},
+			want: "2024-03-02\n",
+		},
+		{
+			name: "add_day_number",
+			args: []string{"add", "0", "1y"},
+			want: "1971-01-01\n",
+		},
+		{
+			name: "sub",
+			args: []string{"sub", "2024-03-01", "1d"},
+			want: "2024-02-29\n",
+		},
+		{
+			name: "sub_negative",
+			args: []string{"sub", "2024-03-01", "-1w"},
+			want: "2024-03-08\n",
+		},
+		{
+			name:       "add_overflow",
+			args:       []string{"add", "2149-06-06", "1d"},
+			wantStatus: 1,
+		},
+		{
+			name:       "add_bad_amount",
+			args:       []string{"add", "2024-03-01", "1h"},
+			wantStatus: 1,
+		},
+		{
+			name:       "add_bad_date",
+			args:       []string{"add", "2024-02-30", "1d"},
+			wantStatus: 1,
+		},
+		{
+			name:       "add_usage",
+			args:       []string{"add", "2024-03-01"},
+			wantStatus: 2,
+		},
+		{
+			name: "diff",
+			args: []string{"diff", "2024-01-01", "2024-12-31"},
+			want: "365\n",
+		},
+		{
+			name: "diff_negative",
+			args: []string{"diff", "2024-12-31", "20240101"},
+			want: "-365\n",
+		},
+		{
+			name:       "diff_usage",
+			args:       []string{"diff", "2024-12-31"},
+			wantStatus: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, nil, &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, status, tt.wantStatus, &stderr)
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
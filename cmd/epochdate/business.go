@@ -0,0 +1,142 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// calendars holds the named calendars accepted by the -cal flag.
+var calendars = map[string]epochdate.HolidayCalendar{
+	"weekends": {Name: "weekends", Weekend: epochdate.SaturdaySunday},
+}
+
+// loadCalendar returns the calendar with the given name, or else reads a
+// calendar from the named file. The file lists one holiday per line, in any
+// form that can be detected automatically; blank lines and lines beginning
+// with # are ignored.
+//
+func loadCalendar(name string, weekend []time.Weekday) (epochdate.HolidayCalendar, error) {
+	if cal, ok := calendars[name]; ok {
+		return cal, nil
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return epochdate.HolidayCalendar{}, err
+	}
+	defer f.Close()
+
+	cal := epochdate.HolidayCalendar{
+		Name:     name,
+		Weekend:  weekend,
+		Holidays: make(epochdate.DateSet),
+	}
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || strings.HasPrefix(s, "#") {
+			continue
+		}
+		d, err := parseDate(s)
+		if err != nil {
+			return cal, fmt.Errorf("%s:%d: %v", name, line, err)
+		}
+		cal.Holidays.Add(d)
+	}
+	return cal, sc.Err()
+}
+
+// parseWeekdays parses a comma-separated list of weekday names.
+func parseWeekdays(s string) ([]time.Weekday, error) {
+	var days []time.Weekday
+	for _, name := range strings.Split(s, ",") {
+		if name == "" {
+			continue
+		}
+		w, err := parseWeekday(name)
+		if err != nil {
+			return nil, err
+		}
+		days = append(days, w)
+	}
+	return days, nil
+}
+
+// runBusiness implements the business-day commands, which take a date and
+// nargs further arguments, and print the result of calling fn.
+//
+func runBusiness(name string, nargs int, args []string, stdout, stderr io.Writer,
+	fn func(cal epochdate.CalendarProvider, d epochdate.Date, args []string) (string, error)) int {
+
+	fs := flag.NewFlagSet("epochdate "+name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	calName := fs.String("cal", "weekends", "calendar name, or file listing one holiday per line")
+	weekend := fs.String("weekend", "sat,sun", "comma-separated weekend days, for calendar files")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 1+nargs {
+		fmt.Fprintf(stderr, "usage: epochdate %s [-cal name|file] [-weekend days] date%s\n",
+			name, strings.Repeat(" n", nargs))
+		return 2
+	}
+
+	days, err := parseWeekdays(*weekend)
+	if err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 2
+	}
+	cal, err := loadCalendar(*calName, days)
+	if err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 1
+	}
+	d, err := parseDate(fs.Arg(0))
+	if err != nil {
+		fmt.Fprintf(stderr, "epochdate: %s: %v\n", fs.Arg(0), err)
+		return 1
+	}
+
+	out, err := fn(cal, d, fs.Args()[1:])
+	if err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 1
+	}
+	fmt.Fprintln(stdout, out)
+	return 0
+}
+
+func runIsBusinessDay(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return runBusiness("is-business-day", 0, args, stdout, stderr,
+		func(cal epochdate.CalendarProvider, d epochdate.Date, args []string) (string, error) {
+			return strconv.FormatBool(epochdate.IsBusinessDay(cal, d)), nil
+		})
+}
+
+func runNextBusinessDay(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return runBusiness("next-business-day", 0, args, stdout, stderr,
+		func(cal epochdate.CalendarProvider, d epochdate.Date, args []string) (string, error) {
+			d, err := epochdate.NextBusinessDay(cal, d)
+			return d.String(), err
+		})
+}
+
+func runAddBusinessDays(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	return runBusiness("add-business-days", 1, args, stdout, stderr,
+		func(cal epochdate.CalendarProvider, d epochdate.Date, args []string) (string, error) {
+			n, err := strconv.Atoi(args[0])
+			if err != nil {
+				return "", err
+			}
+			d, err = epochdate.AddBusinessDays(cal, d, n)
+			return d.String(), err
+		})
+}
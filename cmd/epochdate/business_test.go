@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun_business(t *testing.T) {
+	dir, err := ioutil.TempDir("", "epochdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	holidays := filepath.Join(dir, "holidays.txt")
+	data := "# 2024 holidays\n\n2024-01-01\n20241225\n"
+	if err := ioutil.WriteFile(holidays, []byte(data), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	malformed := filepath.Join(dir, "malformed.txt")
+	if err := ioutil.WriteFile(malformed, []byte("2024-01-01\nblah\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "weekday",
+			args: []string{"is-business-day", "2024-01-01"},
+			want: "true\n",
+		},
+		{
+			name: "weekend",
+			args: []string{"is-business-day", "2024-01-06"},
+			want: "false\n",
+		},
+		{
+			name: "holiday_file",
+			args: []string{"is-business-day", "-cal", holidays, "2024-01-01"},
+			want: "false\n",
+		},
+		{
+			name: "custom_weekend",
+			args: []string{"is-business-day", "-cal", holidays, "-weekend", "fri,sat", "2024-01-07"},
+			want: "true\n",
+		},
+		{
+			name: "next",
+			args: []string{"next-business-day", "-cal", holidays, "2023-12-29"},
+			want: "2024-01-02\n",
+		},
+		{
+			name: "add",
+			args: []string{"add-business-days", "-cal", holidays, "2024-12-20", "3"},
+			want: "2024-12-26\n",
+		},
+		{
+			name: "subtract",
+			args: []string{"add-business-days", "2024-01-08", "-1"},
+			want: "2024-01-05\n",
+		},
+		{
+			name:       "overflow",
+			args:       []string{"next-business-day", "2149-06-06"},
+			wantStatus: 1,
+		},
+		{
+			name:       "missing_file",
+			args:       []string{"is-business-day", "-cal", filepath.Join(dir, "missing"), "2024-01-01"},
+			wantStatus: 1,
+		},
+		{
+			name:       "malformed_file",
+			args:       []string{"is-business-day", "-cal", malformed, "2024-01-01"},
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_count",
+			args:       []string{"add-business-days", "2024-01-08", "x"},
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_weekend",
+			args:       []string{"is-business-day", "-weekend", "sat,someday", "2024-01-08"},
+			wantStatus: 2,
+		},
+		{
+			name:       "usage",
+			args:       []string{"add-business-days", "2024-01-08"},
+			wantStatus: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, nil, &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, status, tt.wantStatus, &stderr)
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
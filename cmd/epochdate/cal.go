@@ -0,0 +1,74 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xtgo/epochdate"
+)
+
+func runCal(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("epochdate cal", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	weekStart := fs.String("week-start", "sun", "first day of each week")
+	mark := fs.String("mark", "", "comma-separated dates to mark")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: epochdate cal [-week-start day] [-mark dates] [year|year-month|date]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() > 1 {
+		fs.Usage()
+		return 2
+	}
+
+	var opts epochdate.RenderOptions
+	var err error
+	if opts.WeekStart, err = parseWeekday(*weekStart); err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 2
+	}
+	marked := make(epochdate.DateSet)
+	if *mark != "" {
+		for _, s := range strings.Split(*mark, ",") {
+			d, err := parseDate(strings.TrimSpace(s))
+			if err != nil {
+				fmt.Fprintf(stderr, "epochdate: %s: %v\n", s, err)
+				return 1
+			}
+			marked.Add(d)
+		}
+	}
+	opts.Highlight = marked.Contains
+
+	// Without an argument, show the current month, marking today.
+	arg := epochdate.TodayUTC().String()
+	if fs.NArg() == 1 {
+		arg = fs.Arg(0)
+	}
+	if year, err := strconv.Atoi(arg); err == nil && len(arg) == 4 {
+		fmt.Fprint(stdout, epochdate.RenderYear(year, opts))
+		return 0
+	}
+	var ym epochdate.YearMonth
+	if len(arg) == len("2006-01") {
+		err = ym.UnmarshalText([]byte(arg))
+	} else {
+		var d epochdate.Date
+		if d, err = parseDate(arg); err == nil {
+			ym = d.YearMonth()
+			marked.Add(d)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "epochdate: %s: %v\n", arg, err)
+		return 1
+	}
+	fmt.Fprint(stdout, epochdate.RenderMonth(ym, opts))
+	return 0
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun_cal(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "month",
+			args: []string{"cal", "2024-02"},
+			want: "   February 2024\n" +
+				"Su Mo Tu We Th Fr Sa\n" +
+				"             1  2  3\n" +
+				" 4  5  6  7  8  9 10\n" +
+				"11 12 13 14 15 16 17\n" +
+				"18 19 20 21 22 23 24\n" +
+				"25 26 27 28 29\n",
+		},
+		{
+			name: "date_marked",
+			args: []string{"cal", "-week-start", "mon", "-mark", "2024-02-05", "2024-02-29"},
+			want: "   February 2024\n" +
+				"Mo Tu We Th Fr Sa Su\n" +
+				"          1  2  3  4\n" +
+				" 5* 6  7  8  9 10 11\n" +
+				"12 13 14 15 16 17 18\n" +
+				"19 20 21 22 23 24 25\n" +
+				"26 27 28 29*\n",
+		},
+		{
+			name:       "bad_month",
+			args:       []string{"cal", "2024-13"},
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_weekday",
+			args:       []string{"cal", "-week-start", "someday", "2024"},
+			wantStatus: 2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(test.args, nil, &stdout, &stderr)
+			if status != test.wantStatus {
+				t.Errorf("status = %d; want %d (stderr %q)", status, test.wantStatus, stderr.String())
+			}
+			if test.wantStatus == 0 && stdout.String() != test.want {
+				t.Errorf("stdout = %q; want %q", stdout.String(), test.want)
+			}
+		})
+	}
+}
+
+func TestRun_calYear(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"cal", "2024"}, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("status = %d (stderr %q)", status, stderr.String())
+	}
+	out := stdout.String()
+	for _, s := range []string{"2024", "January", "December", "29 30 31"} {
+		if !strings.Contains(out, s) {
+			t.Errorf("output does not contain %q:\n%s", s, out)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+//go:build !go1.17
+// +build !go1.17
+
+package main
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+// lineTracker finds the line numbers of fields read from a csv.Reader by
+// counting the lines of each record, since csv.Reader.FieldPos requires Go
+// 1.17. The count assumes that the input begins on line 1, and does not
+// include the blank lines which csv.Reader skips.
+//
+type lineTracker struct {
+	start, next int
+}
+
+// record notes that rec was read, so that its lines are counted.
+func (t *lineTracker) record(rec []string) {
+	if t.next == 0 {
+		t.next = 1
+	}
+	t.start = t.next
+	for _, f := range rec {
+		t.next += strings.Count(f, "\n")
+	}
+	t.next++
+}
+
+// line returns the line on which field i of the last record read from r
+// begins.
+//
+func (t *lineTracker) line(r *csv.Reader, rec []string, i int) int {
+	line := t.start
+	for _, f := range rec[:i] {
+		line += strings.Count(f, "\n")
+	}
+	return line
+}
@@ -0,0 +1,316 @@
+// Command epochdate converts dates between the representations used by the
+// epochdate package and common external forms.
+//
+// Usage:
+//
+//	epochdate [-tz zone] [-from form] [-to form] [value...]
+//	epochdate add date amount
+//	epochdate sub date amount
+//	epochdate diff from to
+//	epochdate seq [-step amount] [-weekday day] [-month] [-to form] start end
+//	epochdate cal [-week-start day] [-mark dates] [year|year-month|date]
+//	epochdate is-business-day [-cal name|file] [-weekend days] date
+//	epochdate next-business-day [-cal name|file] [-weekend days] date
+//	epochdate add-business-days [-cal name|file] [-weekend days] date n
+//	epochdate validate [-column n] [-layout layout] [-header] [-allow-empty] [-comma c] [file.csv...]
+//
+// Each value is converted from the input form to the output form, and
+// printed on its own line. If no values are given, or the only value is "-",
+// values are read from standard input, one per line, so that the command may
+// be used in pipelines. The supported forms are:
+//
+//	rfc       an RFC 3339 date, such as 2024-03-01
+//	day       an ordinal day number (the underlying epochdate.Date value)
+//	unix      a Unix timestamp, in seconds
+//	yyyymmdd  a compact date, such as 20240301
+//
+// By default, the input form is detected automatically: numbers of up to 5
+// digits are day, 8-digit numbers are yyyymmdd, and other numbers are unix.
+// Other values may be in any of a number of common layouts, including rfc,
+// full RFC 3339 timestamps, RFC 1123 timestamps, Unix date(1) output,
+// 2006/01/02, 01/02/2006, 2 Jan 2006, and Jan 2, 2006. Also by default,
+// every output form is printed.
+//
+// Unix timestamps, and other inputs which identify an instant rather than a
+// date (such as those with a UTC offset), are interpreted relative to the
+// location given by -tz (UTC by default), as is unix output, which is the
+// first instant of the date in that location.
+//
+// The add and sub commands add or subtract an amount, such as 45d, to a date,
+// and print the resulting RFC 3339 date. An amount is a sequence of integers,
+// each followed by a unit of y (years), m (months), w (weeks), or d (days),
+// such as 1y2m. Month and year arithmetic normalizes overflowing days in the
+// same way as time.Time.AddDate, so 2024-01-31 plus 1m is 2024-03-02.
+//
+// The diff command prints the number of days from one date until another,
+// which is negative if the second date is earlier.
+//
+// The seq command prints the dates from start through end, inclusive, one per
+// line, separated by the given step (1d by default). With -weekday or
+// -month, the sequence begins on the first date on or after start that falls
+// on that weekday, or that begins a month, respectively. Each date is
+// computed by adding a multiple of the step to the first, so stepping monthly
+// from 2024-01-31 yields 2024-03-02, 2024-03-31, and so on.
+//
+// The cal command prints the calendar of a year, such as 2024, or of a
+// month, such as 2024-03, in the style of cal(1). Given a date, it prints
+// the calendar of its month with the date marked; given nothing, it prints
+// the current month with today marked. The -mark flag marks further dates,
+// and -week-start sets the first day of each week.
+//
+// The is-business-day, next-business-day, and add-business-days commands
+// answer questions about business days according to an
+// epochdate.HolidayCalendar. The -cal flag names a built-in calendar, or a
+// file listing one holiday per line (blank lines and lines beginning with #
+// are ignored), in which case the weekend days are given by -weekend. The
+// only built-in calendar, and the default, is "weekends", which has a
+// Saturday and Sunday weekend and no holidays.
+//
+// The validate command checks that every value in a column of the given CSV
+// files (or standard input) is a representable date in the given layout,
+// reporting each invalid value with its file name and line number, and
+// exiting with a non-zero status if any were found.
+//
+// The dates accepted by these commands may be in any form that can be
+// detected automatically, with unix timestamps interpreted relative to UTC.
+//
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// form is a textual representation of a date.
+type form struct {
+	name   string
+	parse  func(s string, loc *time.Location) (epochdate.Date, error)
+	format func(d epochdate.Date, loc *time.Location) string
+}
+
+var forms = []form{
+	{
+		name: "rfc",
+		parse: func(s string, loc *time.Location) (epochdate.Date, error) {
+			return epochdate.ParseRFC(s)
+		},
+		format: func(d epochdate.Date, loc *time.Location) string {
+			return d.String()
+		},
+	},
+	{
+		name: "day",
+		parse: func(s string, loc *time.Location) (epochdate.Date, error) {
+			n, err := strconv.ParseUint(s, 10, 16)
+			if err != nil {
+				return 0, epochdate.ErrOutOfRange
+			}
+			return epochdate.Date(n), nil
+		},
+		format: func(d epochdate.Date, loc *time.Location) string {
+			return strconv.Itoa(int(d))
+		},
+	},
+	{
+		name: "unix",
+		parse: func(s string, loc *time.Location) (epochdate.Date, error) {
+			n, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			return epochdate.NewFromTimeIn(time.Unix(n, 0), loc)
+		},
+		format: func(d epochdate.Date, loc *time.Location) string {
+			return strconv.FormatInt(d.In(loc).Unix(), 10)
+		},
+	},
+	{
+		name: "yyyymmdd",
+		parse: func(s string, loc *time.Location) (epochdate.Date, error) {
+			return epochdate.Parse("20060102", s)
+		},
+		format: func(d epochdate.Date, loc *time.Location) string {
+			return d.Format("20060102")
+		},
+	},
+}
+
+func lookupForm(name string) (form, error) {
+	for _, f := range forms {
+		if f.name == name {
+			return f, nil
+		}
+	}
+	return form{}, fmt.Errorf("unknown form %q", name)
+}
+
+// autoLayouts holds the layouts tried when detecting the form of
+// non-numeric values. Values with zoned layouts denote instants, and are
+// converted to the date of that instant in the -tz location; other values
+// denote dates, and are converted to that same date.
+//
+var autoLayouts = []struct {
+	layout string
+	zoned  bool
+}{
+	{epochdate.RFC3339, false},
+	{time.RFC3339Nano, true},
+	{"2006-01-02T15:04:05", false},
+	{"2006-01-02 15:04:05Z07:00", true},
+	{"2006-01-02 15:04:05", false},
+	{"2006/01/02", false},
+	{"01/02/2006", false},
+	{"2 Jan 2006", false},
+	{"Jan 2, 2006", false},
+	{"January 2, 2006", false},
+	{time.RFC1123Z, true},
+	{time.RFC1123, true},
+	{time.RFC850, true},
+	{time.UnixDate, true},
+	{time.ANSIC, false},
+}
+
+// layoutForm parses values in any of the autoLayouts.
+var layoutForm = form{
+	name: "layout",
+	parse: func(s string, loc *time.Location) (epochdate.Date, error) {
+		for _, l := range autoLayouts {
+			t, err := time.Parse(l.layout, s)
+			if err != nil {
+				continue
+			}
+			if l.zoned {
+				return epochdate.NewFromTimeIn(t, loc)
+			}
+			return epochdate.NewFromTime(t)
+		}
+		return 0, errors.New("unrecognized date format")
+	},
+}
+
+// detectForm guesses the form of s, as described in the command
+// documentation.
+//
+func detectForm(s string) form {
+	digits := strings.TrimPrefix(s, "-")
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return layoutForm
+	}
+	switch {
+	case len(s) <= 5:
+		return forms[1]
+
+	case len(s) == 8:
+		return forms[3]
+	}
+	return forms[2]
+}
+
+// commands holds the subcommands, keyed by name.
+var commands = map[string]func(args []string, stdin io.Reader, stdout, stderr io.Writer) int{
+	"add":  runAdd,
+	"sub":  runSub,
+	"diff": runDiff,
+	"seq":  runSeq,
+	"cal":  runCal,
+
+	"is-business-day":   runIsBusinessDay,
+	"next-business-day": runNextBusinessDay,
+	"add-business-days": runAddBusinessDays,
+
+	"validate": runValidate,
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) > 0 {
+		if cmd, ok := commands[args[0]]; ok {
+			return cmd(args[1:], stdin, stdout, stderr)
+		}
+	}
+	return runConvert(args, stdin, stdout, stderr)
+}
+
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("epochdate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	tz := fs.String("tz", "UTC", "location used for unix timestamps")
+	from := fs.String("from", "auto", "input form: auto, rfc, day, unix, or yyyymmdd")
+	to := fs.String("to", "all", "output form: all, rfc, day, unix, or yyyymmdd")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	loc, err := time.LoadLocation(*tz)
+	if err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 2
+	}
+
+	var in, out form
+	if *from != "auto" {
+		if in, err = lookupForm(*from); err != nil {
+			fmt.Fprintln(stderr, "epochdate:", err)
+			return 2
+		}
+	}
+	if *to != "all" {
+		if out, err = lookupForm(*to); err != nil {
+			fmt.Fprintln(stderr, "epochdate:", err)
+			return 2
+		}
+	}
+
+	status := 0
+	convert := func(s string) {
+		f := in
+		if *from == "auto" {
+			f = detectForm(s)
+		}
+		d, err := f.parse(s, loc)
+		if err != nil {
+			fmt.Fprintf(stderr, "epochdate: %s: %v\n", s, err)
+			status = 1
+			return
+		}
+		if *to != "all" {
+			fmt.Fprintln(stdout, out.format(d, loc))
+			return
+		}
+		fields := make([]string, len(forms))
+		for i, f := range forms {
+			fields[i] = f.name + "=" + f.format(d, loc)
+		}
+		fmt.Fprintln(stdout, strings.Join(fields, " "))
+	}
+
+	if fs.NArg() > 0 && !(fs.NArg() == 1 && fs.Arg(0) == "-") {
+		for _, s := range fs.Args() {
+			convert(s)
+		}
+		return status
+	}
+
+	sc := bufio.NewScanner(stdin)
+	for sc.Scan() {
+		if s := strings.TrimSpace(sc.Text()); s != "" {
+			convert(s)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 1
+	}
+	return status
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		stdin      string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "rfc_to_all",
+			args: []string{"2024-03-01"},
+			want: "rfc=2024-03-01 day=19783 unix=1709251200 yyyymmdd=20240301\n",
+		},
+		{
+			name: "auto_detect",
+			args: []string{"-to", "rfc", "19783", "1709251200", "20240301", "2024-03-01"},
+			want: "2024-03-01\n2024-03-01\n2024-03-01\n2024-03-01\n",
+		},
+		{
+			name: "explicit_from",
+			args: []string{"-from", "unix", "-to", "day", "86399", "86400"},
+			want: "0\n1\n",
+		},
+		{
+			name: "tz_input",
+			args: []string{"-tz", "Asia/Tokyo", "-to", "rfc", "1709251200"},
+			want: "2024-03-01\n",
+		},
+		{
+			name: "tz_output",
+			args: []string{"-tz", "Asia/Tokyo", "-to", "unix", "2024-03-01"},
+			want: "1709218800\n",
+		},
+		{
+			name:       "out_of_range",
+			args:       []string{"-to", "day", "65536", "1969-12-31", "65535"},
+			want:       "65535\n",
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_form",
+			args:       []string{"-to", "julian", "2024-03-01"},
+			wantStatus: 2,
+		},
+		{
+			name:       "bad_tz",
+			args:       []string{"-tz", "No/Such_Zone", "2024-03-01"},
+			wantStatus: 2,
+		},
+		{
+			name:       "stdin",
+			stdin:      "2024-03-01\n\n  1709251200  \nMar 1, 2024\nblah\n2024-03-01T23:30:00-05:00\n",
+			args:       []string{"-to", "rfc"},
+			want:       "2024-03-01\n2024-03-01\n2024-03-01\n2024-03-02\n",
+			wantStatus: 1,
+		},
+		{
+			name:  "stdin_dash",
+			stdin: "Fri, 01 Mar 2024 23:30:00 -0500\n",
+			args:  []string{"-tz", "America/New_York", "-to", "day", "-"},
+			want:  "19783\n",
+		},
+		{
+			name: "no_args",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, status, tt.wantStatus, &stderr)
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote %q, want %q", tt.args, got, tt.want)
+			}
+
+			if tt.wantStatus != 0 && !strings.Contains(stderr.String(), "epochdate") {
+				t.Errorf("run(%q) wrote %q to stderr, want an error message", tt.args, &stderr)
+			}
+		})
+	}
+}
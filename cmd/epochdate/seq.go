@@ -0,0 +1,115 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// parseWeekday parses a weekday name, such as "Monday", or its
+// three-letter abbreviation, ignoring case.
+//
+func parseWeekday(s string) (time.Weekday, error) {
+	for w := time.Sunday; w <= time.Saturday; w++ {
+		name := w.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			return w, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", s)
+}
+
+// align returns the first date on or after d which falls on the given
+// weekday, if non-nil, and which begins a month, if month is true.
+//
+func align(d epochdate.Date, weekday *time.Weekday, month bool) (epochdate.Date, error) {
+	for {
+		_, _, day := d.Date()
+		ok := !month || day == 1
+		if weekday != nil && d.UTC().Weekday() != *weekday {
+			ok = false
+		}
+		if ok {
+			return d, nil
+		}
+		if d.IsMax() {
+			return d, epochdate.ErrOutOfRange
+		}
+		d++
+	}
+}
+
+func runSeq(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("epochdate seq", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	step := fs.String("step", "1d", "amount between consecutive dates")
+	weekday := fs.String("weekday", "", "start on the first date falling on this weekday")
+	alignMonth := fs.Bool("month", false, "start on the first date beginning a month")
+	to := fs.String("to", "rfc", "output form: rfc, day, unix, or yyyymmdd")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: epochdate seq [-step amount] [-weekday day] [-month] [-to form] start end")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	a, err := parseAmount(*step)
+	if err == nil && a.years <= 0 && a.months <= 0 && a.days <= 0 {
+		err = errors.New("step must advance")
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "epochdate: %s: %v\n", *step, err)
+		return 2
+	}
+	out, err := lookupForm(*to)
+	if err != nil {
+		fmt.Fprintln(stderr, "epochdate:", err)
+		return 2
+	}
+	var w *time.Weekday
+	if *weekday != "" {
+		v, err := parseWeekday(*weekday)
+		if err != nil {
+			fmt.Fprintln(stderr, "epochdate:", err)
+			return 2
+		}
+		w = &v
+	}
+
+	var bounds [2]epochdate.Date
+	for i, s := range fs.Args() {
+		if bounds[i], err = parseDate(s); err != nil {
+			fmt.Fprintf(stderr, "epochdate: %s: %v\n", s, err)
+			return 1
+		}
+	}
+
+	start, err := align(bounds[0], w, *alignMonth)
+	if err != nil {
+		return 0
+	}
+	// each date is computed from start, rather than from its predecessor, so
+	// that month-end dates do not drift when stepping by months.
+	for i, prev := 0, start; ; i++ {
+		d, err := addAmount(start, a.times(i))
+		if err != nil || d > bounds[1] {
+			return 0
+		}
+		if i > 0 && d <= prev {
+			fmt.Fprintf(stderr, "epochdate: %s: step must advance\n", *step)
+			return 1
+		}
+		fmt.Fprintln(stdout, out.format(d, time.UTC))
+		prev = d
+	}
+}
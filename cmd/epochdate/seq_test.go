@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestRun_seq(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "daily",
+			args: []string{"seq", "2024-02-27", "2024-03-01"},
+			want: "2024-02-27\n2024-02-28\n2024-02-29\n2024-03-01\n",
+		},
+		{
+			name: "stepped",
+			args: []string{"seq", "-step", "2d", "2024-02-27", "2024-03-01"},
+			want: "2024-02-27\n2024-02-29\n",
+		},
+		{
+			name: "empty",
+			args: []string{"seq", "2024-03-02", "2024-03-01"},
+		},
+		{
+			name: "weekday",
+			args: []string{"seq", "-weekday", "mon", "-step", "1w", "2024-03-01", "2024-03-31"},
+			want: "2024-03-04\n2024-03-11\n2024-03-18\n2024-03-25\n",
+		},
+		{
+			name: "month",
+			args: []string{"seq", "-month", "-step", "1m", "2024-01-15", "2024-04-30"},
+			want: "2024-02-01\n2024-03-01\n2024-04-01\n",
+		},
+		{
+			name: "month_end_without_drift",
+			args: []string{"seq", "-step", "1m", "2024-01-31", "2024-05-31"},
+			want: "2024-01-31\n2024-03-02\n2024-03-31\n2024-05-01\n2024-05-31\n",
+		},
+		{
+			name: "day_numbers",
+			args: []string{"seq", "-to", "day", "0", "2"},
+			want: "0\n1\n2\n",
+		},
+		{
+			name: "through_max",
+			args: []string{"seq", "2149-06-05", "2149-06-06"},
+			want: "2149-06-05\n2149-06-06\n",
+		},
+		{
+			name:       "backward_step",
+			args:       []string{"seq", "-step", "-1d", "2024-03-01", "2024-03-02"},
+			wantStatus: 2,
+		},
+		{
+			name:       "stalled_step",
+			args:       []string{"seq", "-step", "1m-31d", "2024-03-01", "2024-12-31"},
+			want:       "2024-03-01\n",
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_weekday",
+			args:       []string{"seq", "-weekday", "someday", "2024-03-01", "2024-03-02"},
+			wantStatus: 2,
+		},
+		{
+			name:       "bad_date",
+			args:       []string{"seq", "2024-03-01", "blah"},
+			wantStatus: 1,
+		},
+		{
+			name:       "usage",
+			args:       []string{"seq", "2024-03-01"},
+			wantStatus: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, nil, &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, status, tt.wantStatus, &stderr)
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote %q, want %q", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+
+	"github.com/xtgo/epochdate"
+)
+
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("epochdate validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	column := fs.Int("column", 1, "1-based index of the date column")
+	layout := fs.String("layout", epochdate.RFC3339, "layout of the dates, as accepted by time.Parse")
+	header := fs.Bool("header", false, "skip the first record of each file")
+	allowEmpty := fs.Bool("allow-empty", false, "accept empty dates")
+	comma := fs.String("comma", ",", "field delimiter")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: epochdate validate [-column n] [-layout layout] [-header] [-allow-empty] [-comma c] [file.csv...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	delim, n := utf8.DecodeRuneInString(*comma)
+	if *column < 1 || n == 0 || n != len(*comma) {
+		fs.Usage()
+		return 2
+	}
+
+	v := validator{
+		column:     *column - 1,
+		layout:     *layout,
+		header:     *header,
+		allowEmpty: *allowEmpty,
+		comma:      delim,
+		stdin:      stdin,
+		stdout:     stdout,
+	}
+
+	names := fs.Args()
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+	status := 0
+	for _, name := range names {
+		if err := v.validateFile(name); err != nil {
+			fmt.Fprintln(stderr, "epochdate:", err)
+			return 1
+		}
+	}
+	if v.bad > 0 {
+		fmt.Fprintf(stderr, "epochdate: %d invalid dates\n", v.bad)
+		status = 1
+	}
+	return status
+}
+
+// validator checks the dates in a column of CSV files, reporting each
+// invalid date with its location.
+//
+type validator struct {
+	column     int
+	layout     string
+	header     bool
+	allowEmpty bool
+	comma      rune
+	stdin      io.Reader
+	stdout     io.Writer
+	bad        int
+}
+
+func (v *validator) validateFile(name string) error {
+	r := v.stdin
+	if name == "-" {
+		name = "<stdin>"
+	} else {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+	return v.validate(name, r)
+}
+
+var errMissingColumn = errors.New("missing date column")
+
+func (v *validator) validate(name string, r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.Comma = v.comma
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+
+	var lines lineTracker
+	for i := 0; ; i++ {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", name, err)
+		}
+		lines.record(rec)
+		if i == 0 && v.header {
+			continue
+		}
+
+		if v.column >= len(rec) {
+			v.report(name, lines.line(cr, rec, 0), "", errMissingColumn)
+			continue
+		}
+		s := rec[v.column]
+		if s == "" && v.allowEmpty {
+			continue
+		}
+		if _, err := epochdate.Parse(v.layout, s); err != nil {
+			v.report(name, lines.line(cr, rec, v.column), s, err)
+		}
+	}
+}
+
+func (v *validator) report(name string, line int, s string, err error) {
+	v.bad++
+	fmt.Fprintf(v.stdout, "%s:%d: %q: %v\n", name, line, s, err)
+}
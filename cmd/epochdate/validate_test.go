@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun_validate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "epochdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"good.csv": "id,name,date\n1,a,2024-03-01\n2,b,2149-06-06\n",
+		"bad.csv": "id,name,date\n" +
+			"1,a,2024-03-01\n" +
+			"2,b,2024-02-30\n" +
+			"3,\"multi\nline\",1969-12-31\n" +
+			"4,c\n" +
+			"5,d,\n",
+		"semicolon.csv": "1;01/03/2024\n2;32/03/2024\n",
+		"malformed.csv": "1,\"unterminated\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "good",
+			args: []string{"validate", "-column", "3", "-header", path("good.csv")},
+		},
+		{
+			name: "bad",
+			args: []string{"validate", "-column=3", "-header", path("bad.csv")},
+			want: path("bad.csv") + `:3: "2024-02-30": parsing time "2024-02-30": day out of range` + "\n" +
+				path("bad.csv") + `:5: "1969-12-31": ` + "epochdate: dates must be in the range [1970-01-01,2149-06-06]\n" +
+				path("bad.csv") + `:6: "": missing date column` + "\n" +
+				path("bad.csv") + `:7: "": parsing time "" as "2006-01-02": cannot parse "" as "2006"` + "\n",
+			wantStatus: 1,
+		},
+		{
+			name: "allow_empty",
+			args: []string{"validate", "-column=3", "-header", "-allow-empty", path("bad.csv")},
+			want: path("bad.csv") + `:3: "2024-02-30": parsing time "2024-02-30": day out of range` + "\n" +
+				path("bad.csv") + `:5: "1969-12-31": ` + "epochdate: dates must be in the range [1970-01-01,2149-06-06]\n" +
+				path("bad.csv") + `:6: "": missing date column` + "\n",
+			wantStatus: 1,
+		},
+		{
+			name:       "layout_and_comma",
+			args:       []string{"validate", "-column=2", "-comma", ";", "-layout", "02/01/2006", path("semicolon.csv")},
+			want:       path("semicolon.csv") + `:2: "32/03/2024": parsing time "32/03/2024": day out of range` + "\n",
+			wantStatus: 1,
+		},
+		{
+			name:       "header_counts_as_data",
+			args:       []string{"validate", "-column=3", path("good.csv")},
+			want:       path("good.csv") + `:1: "date": parsing time "date" as "2006-01-02": cannot parse "date" as "2006"` + "\n",
+			wantStatus: 1,
+		},
+		{
+			name:       "malformed",
+			args:       []string{"validate", path("malformed.csv")},
+			wantStatus: 1,
+		},
+		{
+			name:       "missing",
+			args:       []string{"validate", path("missing.csv")},
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_column",
+			args:       []string{"validate", "-column=0", path("good.csv")},
+			wantStatus: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, nil, &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, status, tt.wantStatus, &stderr)
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote:\n%s\nwant:\n%s", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"math"
+
+	"github.com/xtgo/epochdate"
+)
+
+// The conversions below validate numeric arguments received from JavaScript.
+// They do not depend on syscall/js, so that they may be tested on any
+// platform; ok reports whether the argument was a JavaScript number at all.
+
+var (
+	errNotDate = errors.New("epochdate: value is not a representable date")
+	errNotInt  = errors.New("epochdate: argument is not an integer")
+	errNotUnix = errors.New("epochdate: value is not an integral number of Unix seconds")
+)
+
+// maxUnix bounds the magnitude of Unix seconds accepted from JavaScript, such
+// that the conversion to int64 is exact; dates this far out of range are
+// rejected by epochdate anyway.
+//
+const maxUnix = 1 << 53
+
+// toInt converts f to an int, failing unless it is an integer which fits in
+// an int32.
+//
+func toInt(f float64, ok bool) (int, error) {
+	if !ok || f != math.Trunc(f) || f < math.MinInt32 || f > math.MaxInt32 {
+		return 0, errNotInt
+	}
+	return int(f), nil
+}
+
+// toUnix converts f to a count of Unix seconds, failing unless it is an
+// integer which can be represented exactly.
+//
+func toUnix(f float64, ok bool) (int64, error) {
+	if !ok || f != math.Trunc(f) || f < -maxUnix || f > maxUnix {
+		return 0, errNotUnix
+	}
+	return int64(f), nil
+}
+
+// toDate converts f to a Date, failing unless it is an integer in the
+// representable range.
+//
+func toDate(f float64, ok bool) (epochdate.Date, error) {
+	if !ok || f != math.Trunc(f) || f < 0 || f > float64(^epochdate.Date(0)) {
+		return 0, errNotDate
+	}
+	return epochdate.Date(f), nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/xtgo/epochdate"
+)
+
+func TestArgs(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	tests := []struct {
+		f        float64
+		ok       bool
+		wantInt  bool
+		wantUnix bool
+		wantDate bool
+	}{
+		{f: 0, ok: true, wantInt: true, wantUnix: true, wantDate: true},
+		{f: 19000, ok: true, wantInt: true, wantUnix: true, wantDate: true},
+		{f: 65535, ok: true, wantInt: true, wantUnix: true, wantDate: true},
+		{f: 65536, ok: true, wantInt: true, wantUnix: true},
+		{f: -1, ok: true, wantInt: true, wantUnix: true},
+		{f: math.MaxInt32, ok: true, wantInt: true, wantUnix: true},
+		{f: math.MaxInt32 + 1, ok: true, wantUnix: true},
+		{f: math.MinInt32 - 1, ok: true, wantUnix: true},
+		{f: 1 << 53, ok: true, wantUnix: true},
+		{f: 1 << 54, ok: true},
+		{f: -(1 << 54), ok: true},
+		{f: 1e300, ok: true},
+		{f: 1.5, ok: true},
+		{f: nan, ok: true},
+		{f: inf, ok: true},
+		{f: -inf, ok: true},
+		{f: 0, ok: false},
+		{f: 1, ok: false},
+	}
+	for _, test := range tests {
+		if n, err := toInt(test.f, test.ok); (err == nil) != test.wantInt {
+			t.Errorf("toInt(%v, %t) = %d, %v; want success %t", test.f, test.ok, n, err, test.wantInt)
+		} else if err == nil && float64(n) != test.f {
+			t.Errorf("toInt(%v, %t) = %d", test.f, test.ok, n)
+		}
+		if n, err := toUnix(test.f, test.ok); (err == nil) != test.wantUnix {
+			t.Errorf("toUnix(%v, %t) = %d, %v; want success %t", test.f, test.ok, n, err, test.wantUnix)
+		} else if err == nil && float64(n) != test.f {
+			t.Errorf("toUnix(%v, %t) = %d", test.f, test.ok, n)
+		}
+		if d, err := toDate(test.f, test.ok); (err == nil) != test.wantDate {
+			t.Errorf("toDate(%v, %t) = %v, %v; want success %t", test.f, test.ok, d, err, test.wantDate)
+		} else if err == nil && d != epochdate.Date(test.f) {
+			t.Errorf("toDate(%v, %t) = %v", test.f, test.ok, d)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+//go:build js && wasm
+// +build js,wasm
+
+// Command epochdatejs exposes epochdate to JavaScript when compiled to
+// WebAssembly, so that front-ends share the exact same date semantics,
+// including range limits and clamping, as Go backends. Build it with:
+//
+//	GOOS=js GOARCH=wasm go build -o epochdate.wasm github.com/xtgo/epochdate/cmd/epochdatejs
+//
+// and load it using the wasm_exec.js support file distributed with Go. Once
+// running, the program installs a global epochdate object, and then waits
+// indefinitely so that its functions remain callable.
+//
+// Dates are exchanged as numbers of days since 1970-01-01, which is the
+// underlying representation of epochdate.Date, so they may be compared and
+// stored directly by JavaScript code. Functions which may fail return a
+// JavaScript Error object rather than a result; callers should check results
+// with instanceof Error. The epochdate object has the following members:
+//
+//	min, max                             // the first and last representable dates
+//	parse(value)                         // parse an RFC 3339 date, e.g. "2024-03-01"
+//	parseLayout(layout, value)           // parse using a Go time layout
+//	fromDate(year, month, day)           // month is 1-based; may fail
+//	clampFromDate(year, month, day)
+//	fromUnix(seconds)                    // may fail
+//	clampFromUnix(seconds)
+//	today()                              // today's date in UTC
+//	format(date)                         // RFC 3339 date
+//	formatLayout(date, layout)           // format using a Go time layout
+//	date(date)                           // [year, month, day]
+//	unix(date)                           // seconds at the start of the date in UTC
+//	unixRange(date)                      // [start, end) in Unix seconds
+//	addDate(date, years, months, days)   // may fail
+//	addDays(date, days)                  // may fail
+//	daysUntil(date, other)
+//	inRange(date, start, end)            // start <= date <= end
+//
+// Out-of-range results are errors regardless of epochdate.Clamp; the clamp
+// functions should be used where clamping is intended. Numeric arguments
+// must be integers; anything else, including a missing argument, results in
+// an Error.
+//
+package main
+
+import (
+	"syscall/js"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+func main() {
+	// Results must match the Go API exactly, rather than depending on
+	// configuration, so errors are never silently clamped.
+	epochdate.Clamp = false
+
+	obj := js.Global().Get("Object").New()
+	obj.Set("min", 0)
+	obj.Set("max", int(^epochdate.Date(0)))
+	for name, fn := range funcs {
+		obj.Set(name, js.FuncOf(wrap(fn)))
+	}
+	js.Global().Set("epochdate", obj)
+
+	select {}
+}
+
+// funcs holds the functions installed on the epochdate object.
+var funcs = map[string]func(args []js.Value) (interface{}, error){
+	"parse": func(args []js.Value) (interface{}, error) {
+		d, err := epochdate.ParseRFC(str(args, 0))
+		return int(d), err
+	},
+	"parseLayout": func(args []js.Value) (interface{}, error) {
+		d, err := epochdate.Parse(str(args, 0), str(args, 1))
+		return int(d), err
+	},
+	"fromDate": func(args []js.Value) (interface{}, error) {
+		n, err := nums(args, 0, 3)
+		if err != nil {
+			return nil, err
+		}
+		d, err := epochdate.NewFromDate(n[0], time.Month(n[1]), n[2])
+		return int(d), err
+	},
+	"clampFromDate": func(args []js.Value) (interface{}, error) {
+		n, err := nums(args, 0, 3)
+		if err != nil {
+			return nil, err
+		}
+		return int(epochdate.ClampFromDate(n[0], time.Month(n[1]), n[2])), nil
+	},
+	"fromUnix": func(args []js.Value) (interface{}, error) {
+		sec, err := toUnix(number(args, 0))
+		if err != nil {
+			return nil, err
+		}
+		d, err := epochdate.NewFromUnix(sec)
+		return int(d), err
+	},
+	"clampFromUnix": func(args []js.Value) (interface{}, error) {
+		sec, err := toUnix(number(args, 0))
+		if err != nil {
+			return nil, err
+		}
+		return int(epochdate.ClampFromUnix(sec)), nil
+	},
+	"today": func(args []js.Value) (interface{}, error) {
+		d, err := epochdate.TodayUTCErr()
+		return int(d), err
+	},
+	"format": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		return d.String(), err
+	},
+	"formatLayout": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		return d.Format(str(args, 1)), err
+	},
+	"date": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		y, m, dd := d.Date()
+		return []interface{}{y, int(m), dd}, err
+	},
+	"unix": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		return float64(d.Unix()), err
+	},
+	"unixRange": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		start, end := d.UnixRange()
+		return []interface{}{float64(start), float64(end)}, err
+	},
+	"addDate": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		n, err := nums(args, 1, 3)
+		if err != nil {
+			return nil, err
+		}
+		d, err = epochdate.NewFromTime(d.UTC().AddDate(n[0], n[1], n[2]))
+		return int(d), err
+	},
+	"addDays": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		days, err := toInt(number(args, 1))
+		if err != nil {
+			return nil, err
+		}
+		d, err = epochdate.NewFromTime(d.UTC().AddDate(0, 0, days))
+		return int(d), err
+	},
+	"daysUntil": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		other, err := date(args, 1)
+		return d.DaysUntil(other), err
+	},
+	"inRange": func(args []js.Value) (interface{}, error) {
+		d, err := date(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		start, err := date(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		end, err := date(args, 2)
+		return start <= d && d <= end, err
+	},
+}
+
+// wrap adapts fn to the calling convention of js.FuncOf, converting errors
+// to JavaScript Error objects.
+//
+func wrap(fn func(args []js.Value) (interface{}, error)) func(this js.Value, args []js.Value) interface{} {
+	return func(this js.Value, args []js.Value) interface{} {
+		v, err := fn(args)
+		if err != nil {
+			return js.Global().Get("Error").New(err.Error())
+		}
+		return v
+	}
+}
+
+// arg returns the i'th argument, or undefined if it is missing.
+func arg(args []js.Value, i int) js.Value {
+	if i < len(args) {
+		return args[i]
+	}
+	return js.Undefined()
+}
+
+func str(args []js.Value, i int) string {
+	return arg(args, i).String()
+}
+
+// number returns the i'th argument as a float64, and whether it is a
+// JavaScript number at all; other types would make js.Value.Float panic.
+//
+func number(args []js.Value, i int) (float64, bool) {
+	v := arg(args, i)
+	if v.Type() != js.TypeNumber {
+		return 0, false
+	}
+	return v.Float(), true
+}
+
+// nums returns n consecutive integer arguments starting at the i'th.
+func nums(args []js.Value, i, n int) ([]int, error) {
+	v := make([]int, n)
+	for j := range v {
+		var err error
+		if v[j], err = toInt(number(args, i+j)); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// date returns the i'th argument as a Date, failing unless it is an integer
+// in the representable range.
+//
+func date(args []js.Value, i int) (epochdate.Date, error) {
+	return toDate(number(args, i))
+}
@@ -0,0 +1,17 @@
+//go:build !js || !wasm
+// +build !js !wasm
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// main exists only so that the package, and its argument validation, builds
+// and tests on every platform; the bindings themselves require js/wasm.
+//
+func main() {
+	fmt.Fprintln(os.Stderr, "epochdatejs: must be built with GOOS=js GOARCH=wasm")
+	os.Exit(2)
+}
@@ -0,0 +1,249 @@
+// Command holidaygen compiles holiday definitions into Go source declaring
+// an epochdate.HolidayCalendar, so that organizations can maintain their own
+// calendars as data, and regenerate the code when the data changes. It is
+// intended for use with go generate:
+//
+//	//go:generate go run github.com/xtgo/epochdate/cmd/holidaygen -in nyse.csv -var NYSE -o nyse.go
+//
+// Usage:
+//
+//	holidaygen -in file [-o file] [-pkg name] [-var name] [-name name] [-weekend days]
+//
+// The input format is determined by the file extension. CSV files (.csv)
+// have a header row, followed by one holiday per row; the "date" column
+// holds an RFC 3339 date, and the optional "name" column describes the
+// holiday. JSON files (.json) hold a single object:
+//
+//	{
+//		"name": "NYSE",
+//		"weekend": ["sat", "sun"],
+//		"holidays": [
+//			{"date": "2024-01-01", "name": "New Year's Day"}
+//		]
+//	}
+//
+// The name and weekend fields of JSON input are optional, and are overridden
+// by the -name and -weekend flags when those are given. The generated
+// calendar is named by -name, defaulting to the variable name, and has a
+// Saturday and Sunday weekend unless specified otherwise.
+//
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// holiday is a single holiday definition.
+type holiday struct {
+	Date epochdate.Date `json:"date"`
+	Name string         `json:"name"`
+}
+
+// calendar is the JSON input format.
+type calendar struct {
+	Name     string    `json:"name"`
+	Weekend  []string  `json:"weekend"`
+	Holidays []holiday `json:"holidays"`
+}
+
+func readCSV(r io.Reader) (calendar, error) {
+	var cal calendar
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return cal, err
+	}
+	if len(records) == 0 {
+		return cal, errors.New("missing CSV header")
+	}
+
+	dateCol, nameCol := -1, -1
+	for i, h := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(h)) {
+		case "date":
+			dateCol = i
+		case "name":
+			nameCol = i
+		}
+	}
+	if dateCol < 0 {
+		return cal, errors.New(`missing "date" column in CSV header`)
+	}
+
+	for i, rec := range records[1:] {
+		d, err := epochdate.ParseRFC(strings.TrimSpace(rec[dateCol]))
+		if err != nil {
+			return cal, fmt.Errorf("line %d: %v", i+2, err)
+		}
+		h := holiday{Date: d}
+		if nameCol >= 0 {
+			h.Name = strings.TrimSpace(rec[nameCol])
+		}
+		cal.Holidays = append(cal.Holidays, h)
+	}
+	return cal, nil
+}
+
+func readJSON(r io.Reader) (calendar, error) {
+	var cal calendar
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(&cal)
+	return cal, err
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for w := time.Sunday; w <= time.Saturday; w++ {
+		name := w.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			return w, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", s)
+}
+
+// generate writes Go source declaring cal as the variable name in package
+// pkg.
+//
+func generate(w io.Writer, cal calendar, pkg, name string) error {
+	var weekend []time.Weekday
+	for _, s := range cal.Weekend {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		d, err := parseWeekday(s)
+		if err != nil {
+			return err
+		}
+		weekend = append(weekend, d)
+	}
+
+	sort.SliceStable(cal.Holidays, func(i, j int) bool {
+		return cal.Holidays[i].Date < cal.Holidays[j].Date
+	})
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by holidaygen; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	if len(weekend) > 0 {
+		fmt.Fprintf(&buf, "import (\n\"time\"\n\n\"github.com/xtgo/epochdate\"\n)\n\n")
+	} else {
+		fmt.Fprintf(&buf, "import \"github.com/xtgo/epochdate\"\n\n")
+	}
+	fmt.Fprintf(&buf, "// %s is the %q holiday calendar.\n", name, cal.Name)
+	fmt.Fprintf(&buf, "var %s = epochdate.HolidayCalendar{\n", name)
+	fmt.Fprintf(&buf, "Name: %q,\n", cal.Name)
+	if len(weekend) > 0 {
+		fmt.Fprintf(&buf, "Weekend: []time.Weekday{")
+		for i, d := range weekend {
+			if i > 0 {
+				fmt.Fprintf(&buf, ", ")
+			}
+			fmt.Fprintf(&buf, "time.%s", d)
+		}
+		fmt.Fprintf(&buf, "},\n")
+	}
+	fmt.Fprintf(&buf, "Holidays: epochdate.NewDateSet(\n")
+	for _, h := range cal.Holidays {
+		// Names are untrusted input, so line breaks must not escape the
+		// comment.
+		comment := h.Date.String()
+		if name := strings.Join(strings.Fields(h.Name), " "); name != "" {
+			comment += " " + name
+		}
+		fmt.Fprintf(&buf, "%d, // %s\n", h.Date, comment)
+	}
+	fmt.Fprintf(&buf, "),\n}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("holidaygen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	in := fs.String("in", "", "input file (.csv or .json)")
+	out := fs.String("o", "", "output file (default standard output)")
+	pkg := fs.String("pkg", os.Getenv("GOPACKAGE"), "package name (default $GOPACKAGE)")
+	varName := fs.String("var", "Holidays", "name of the generated variable")
+	calName := fs.String("name", "", "calendar name (default from input, or -var)")
+	weekend := fs.String("weekend", "", `comma-separated weekend days (default from input, or "sat,sun")`)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *in == "" || *pkg == "" || fs.NArg() != 0 {
+		fmt.Fprintln(stderr, "usage: holidaygen -in file [-o file] [-pkg name] [-var name] [-name name] [-weekend days]")
+		return 2
+	}
+
+	status := func(err error) int {
+		fmt.Fprintln(stderr, "holidaygen:", err)
+		return 1
+	}
+
+	f, err := os.Open(*in)
+	if err != nil {
+		return status(err)
+	}
+	defer f.Close()
+
+	var cal calendar
+	switch ext := strings.ToLower(filepath.Ext(*in)); ext {
+	case ".csv":
+		cal, err = readCSV(f)
+	case ".json":
+		cal, err = readJSON(f)
+	default:
+		err = fmt.Errorf("unsupported input format %q", ext)
+	}
+	if err != nil {
+		return status(fmt.Errorf("%s: %v", *in, err))
+	}
+
+	if *calName != "" {
+		cal.Name = *calName
+	} else if cal.Name == "" {
+		cal.Name = *varName
+	}
+	if *weekend != "" {
+		cal.Weekend = strings.Split(*weekend, ",")
+	} else if cal.Weekend == nil {
+		cal.Weekend = []string{"sat", "sun"}
+	}
+
+	var buf bytes.Buffer
+	if err := generate(&buf, cal, *pkg, *varName); err != nil {
+		return status(err)
+	}
+	if *out == "" {
+		_, err = stdout.Write(buf.Bytes())
+	} else {
+		err = ioutil.WriteFile(*out, buf.Bytes(), 0666)
+	}
+	if err != nil {
+		return status(err)
+	}
+	return 0
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	dir, err := ioutil.TempDir("", "holidaygen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"holidays.csv": "date,name\n2024-12-25,Christmas Day\n2024-01-01,New Year's Day\n",
+		"dates.csv":    "date\n2024-01-01\n",
+		"bad.csv":      "when,name\n2024-01-01,New Year's Day\n",
+		"holidays.json": `{
+			"name": "Gulf",
+			"weekend": ["fri", "sat"],
+			"holidays": [{"date": "2024-01-01", "name": "New Year's Day"}]
+		}`,
+		"bad.json":     `{"holidays": [{"date": "2024-02-30"}]}`,
+		"inject.json":  `{"holidays": [{"date": "2024-01-01", "name": "New Year's Day\nvar Injected = 1\r\n"}]}`,
+		"holidays.txt": "2024-01-01\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       string
+		wantStatus int
+	}{
+		{
+			name: "csv",
+			args: []string{"-pkg", "cal", "-var", "Company", "-in", filepath.Join(dir, "holidays.csv")},
+			want: `// Code generated by holidaygen; DO NOT EDIT.
+
+package cal
+
+import (
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// Company is the "Company" holiday calendar.
+var Company = epochdate.HolidayCalendar{
+	Name:    "Company",
+	Weekend: []time.Weekday{time.Saturday, time.Sunday},
+	Holidays: epochdate.NewDateSet(
+		19723, // 2024-01-01 New Year's Day
+		20082, // 2024-12-25 Christmas Day
+	),
+}
+`,
+		},
+		{
+			name: "csv_without_names_or_weekend",
+			args: []string{"-pkg", "cal", "-weekend", ",", "-in", filepath.Join(dir, "dates.csv")},
+			want: `// Code generated by holidaygen; DO NOT EDIT.
+
+package cal
+
+import "github.com/xtgo/epochdate"
+
+// Holidays is the "Holidays" holiday calendar.
+var Holidays = epochdate.HolidayCalendar{
+	Name: "Holidays",
+	Holidays: epochdate.NewDateSet(
+		19723, // 2024-01-01
+	),
+}
+`,
+		},
+		{
+			name: "json",
+			args: []string{"-pkg", "cal", "-in", filepath.Join(dir, "holidays.json")},
+			want: `// Code generated by holidaygen; DO NOT EDIT.
+
+package cal
+
+import (
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// Holidays is the "Gulf" holiday calendar.
+var Holidays = epochdate.HolidayCalendar{
+	Name:    "Gulf",
+	Weekend: []time.Weekday{time.Friday, time.Saturday},
+	Holidays: epochdate.NewDateSet(
+		19723, // 2024-01-01 New Year's Day
+	),
+}
+`,
+		},
+		{
+			name: "json_name_with_newlines",
+			args: []string{"-pkg", "cal", "-weekend", ",", "-in", filepath.Join(dir, "inject.json")},
+			want: `// Code generated by holidaygen; DO NOT EDIT.
+
+package cal
+
+import "github.com/xtgo/epochdate"
+
+// Holidays is the "Holidays" holiday calendar.
+var Holidays = epochdate.HolidayCalendar{
+	Name: "Holidays",
+	Holidays: epochdate.NewDateSet(
+		19723, // 2024-01-01 New Year's Day var Injected = 1
+	),
+}
+`,
+		},
+		{
+			name:       "missing_date_column",
+			args:       []string{"-pkg", "cal", "-in", filepath.Join(dir, "bad.csv")},
+			wantStatus: 1,
+		},
+		{
+			name:       "invalid_date",
+			args:       []string{"-pkg", "cal", "-in", filepath.Join(dir, "bad.json")},
+			wantStatus: 1,
+		},
+		{
+			name:       "unsupported_format",
+			args:       []string{"-pkg", "cal", "-in", filepath.Join(dir, "holidays.txt")},
+			wantStatus: 1,
+		},
+		{
+			name:       "bad_weekend",
+			args:       []string{"-pkg", "cal", "-weekend", "someday", "-in", filepath.Join(dir, "dates.csv")},
+			wantStatus: 1,
+		},
+		{
+			name:       "usage",
+			args:       []string{"-pkg", "cal"},
+			wantStatus: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, &stdout, &stderr)
+			if status != tt.wantStatus {
+				t.Errorf("run(%q) = %d, want %d; stderr:\n%s", tt.args, status, tt.wantStatus, &stderr)
+			}
+
+			if got := stdout.String(); got != tt.want {
+				t.Errorf("run(%q) wrote:\n%s\nwant:\n%s", tt.args, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package epochdate
+
+// Before reports whether d is before other.
+func (d Date) Before(other Date) bool {
+	return d < other
+}
+
+// After reports whether d is after other.
+func (d Date) After(other Date) bool {
+	return d > other
+}
+
+// Equal reports whether d and other are the same date.
+func (d Date) Equal(other Date) bool {
+	return d == other
+}
+
+// Compare returns -1 if d is before other, +1 if d is after other, and 0
+// if they are the same date, as time.Time.Compare does, for use with
+// sorting functions such as slices.SortFunc.
+//
+func (d Date) Compare(other Date) int {
+	switch {
+	case d < other:
+		return -1
+	case d > other:
+		return +1
+	}
+	return 0
+}
+
+// Before reports whether ym is before other.
+func (ym YearMonth) Before(other YearMonth) bool {
+	return ym < other
+}
+
+// After reports whether ym is after other.
+func (ym YearMonth) After(other YearMonth) bool {
+	return ym > other
+}
+
+// Equal reports whether ym and other are the same month.
+func (ym YearMonth) Equal(other YearMonth) bool {
+	return ym == other
+}
+
+// Compare returns -1 if ym is before other, +1 if ym is after other, and 0
+// if they are the same month.
+//
+func (ym YearMonth) Compare(other YearMonth) int {
+	switch {
+	case ym < other:
+		return -1
+	case ym > other:
+		return +1
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+package epochdate
+
+import "testing"
+
+func TestDate_Compare(t *testing.T) {
+	a, b := MustParseRFC("2024-01-01"), MustParseRFC("2024-01-02")
+	tests := []struct {
+		x, y                 Date
+		before, after, equal bool
+		cmp                  int
+	}{
+		{a, b, true, false, false, -1},
+		{b, a, false, true, false, +1},
+		{a, a, false, false, true, 0},
+		{0, maxDate, true, false, false, -1},
+	}
+	for _, test := range tests {
+		if got := test.x.Before(test.y); got != test.before {
+			t.Errorf("%v.Before(%v) = %v", test.x, test.y, got)
+		}
+		if got := test.x.After(test.y); got != test.after {
+			t.Errorf("%v.After(%v) = %v", test.x, test.y, got)
+		}
+		if got := test.x.Equal(test.y); got != test.equal {
+			t.Errorf("%v.Equal(%v) = %v", test.x, test.y, got)
+		}
+		if got := test.x.Compare(test.y); got != test.cmp {
+			t.Errorf("%v.Compare(%v) = %d; want %d", test.x, test.y, got, test.cmp)
+		}
+	}
+}
+
+func TestYearMonth_Compare(t *testing.T) {
+	a, b := MustParseRFC("2024-01-31").YearMonth(), MustParseRFC("2024-02-01").YearMonth()
+	if !a.Before(b) || a.After(b) || a.Equal(b) || a.Compare(b) != -1 {
+		t.Errorf("comparisons of %v with %v are wrong", a, b)
+	}
+	if b.Before(a) || !b.After(a) || b.Compare(a) != +1 {
+		t.Errorf("comparisons of %v with %v are wrong", b, a)
+	}
+	if !a.Equal(a) || a.Compare(a) != 0 {
+		t.Errorf("comparisons of %v with itself are wrong", a)
+	}
+}
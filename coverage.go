@@ -0,0 +1,107 @@
+package epochdate
+
+import "sort"
+
+// Coverage describes which dates of a range are covered, such as by
+// insurance policies or contracts, and which are gaps in that coverage.
+type Coverage struct {
+	// Covered holds the maximal covered ranges, in chronological order.
+	Covered []DateRange
+
+	// Gaps holds the maximal uncovered ranges, in chronological order.
+	Gaps []DateRange
+
+	// CoveredDays and GapDays are the total numbers of covered and
+	// uncovered dates, respectively.
+	CoveredDays int
+	GapDays     int
+}
+
+// Complete reports whether every date of the range is covered.
+func (c Coverage) Complete() bool {
+	return len(c.Gaps) == 0
+}
+
+// LongestGap returns the longest gap, or the first of them if several are
+// equally long, and its length in days. It returns zero values if coverage
+// is complete.
+//
+func (c Coverage) LongestGap() (DateRange, int) {
+	var longest DateRange
+	n := 0
+	for _, g := range c.Gaps {
+		if g.Len() > n {
+			longest, n = g, g.Len()
+		}
+	}
+	return longest, n
+}
+
+// CoverageBySet returns the coverage of r by the dates in s.
+func CoverageBySet(r DateRange, s DateSet) Coverage {
+	var covered []DateRange
+	for d := int(r.Start); d <= int(r.End); d++ {
+		if !s.Contains(Date(d)) {
+			continue
+		}
+		if n := len(covered); n > 0 && int(covered[n-1].End)+1 == d {
+			covered[n-1].End = Date(d)
+		} else {
+			covered = append(covered, DateRange{Start: Date(d), End: Date(d)})
+		}
+	}
+	return newCoverage(r, covered)
+}
+
+// CoverageByRanges returns the coverage of r by the union of ranges, which
+// may overlap, abut, extend beyond r, and be given in any order. Empty
+// ranges cover nothing.
+//
+func CoverageByRanges(r DateRange, ranges []DateRange) Coverage {
+	var clipped []DateRange
+	for _, c := range ranges {
+		// A side clipped to r is open only if that side of r is.
+		if c.Start <= r.Start {
+			c.Start, c.OpenStart = r.Start, r.OpenStart
+		}
+		if c.End >= r.End {
+			c.End, c.OpenEnd = r.End, r.OpenEnd
+		}
+		if c.Len() > 0 {
+			clipped = append(clipped, c)
+		}
+	}
+	sort.Slice(clipped, func(i, j int) bool { return clipped[i].Start < clipped[j].Start })
+
+	var covered []DateRange
+	for _, c := range clipped {
+		if n := len(covered); n > 0 && int(c.Start) <= int(covered[n-1].End)+1 {
+			if c.End > covered[n-1].End {
+				covered[n-1].End, covered[n-1].OpenEnd = c.End, c.OpenEnd
+			}
+			continue
+		}
+		covered = append(covered, c)
+	}
+	return newCoverage(r, covered)
+}
+
+// newCoverage returns the coverage of r given its maximal covered ranges, in
+// chronological order.
+//
+func newCoverage(r DateRange, covered []DateRange) Coverage {
+	c := Coverage{Covered: covered}
+	next := int(r.Start)
+	for _, v := range covered {
+		if int(v.Start) > next {
+			c.Gaps = append(c.Gaps, DateRange{Start: Date(next), End: v.Start - 1})
+		}
+		next = int(v.End) + 1
+		c.CoveredDays += v.Len()
+	}
+	if next <= int(r.End) {
+		c.Gaps = append(c.Gaps, DateRange{Start: Date(next), End: r.End})
+	}
+	c.GapDays = r.Len() - c.CoveredDays
+	return c
+}
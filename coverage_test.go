@@ -0,0 +1,80 @@
+package epochdate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCoverageByRanges(t *testing.T) {
+	r := DateRange{Start: 10, End: 50}
+	c := CoverageByRanges(r, []DateRange{
+		{Start: 30, End: 35},
+		{Start: 0, End: 12},
+		{Start: 13, End: 15},
+		{Start: 32, End: 40},
+		{Start: 48, End: 60},
+		{Start: 20, End: 19}, // empty
+	})
+	want := Coverage{
+		Covered:     []DateRange{{Start: 10, End: 15}, {Start: 30, End: 40}, {Start: 48, End: 50}},
+		Gaps:        []DateRange{{Start: 16, End: 29}, {Start: 41, End: 47}},
+		CoveredDays: 20,
+		GapDays:     21,
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Fatalf("CoverageByRanges = %+v; want %+v", c, want)
+	}
+	if c.Complete() {
+		t.Error("Complete() = true; want false")
+	}
+	if g, n := c.LongestGap(); g != (DateRange{Start: 16, End: 29}) || n != 14 {
+		t.Errorf("LongestGap() = %v, %d; want {16 29}, 14", g, n)
+	}
+
+	c = CoverageByRanges(DateRange{Start: 0, End: maxDate}, []DateRange{{Start: 0, End: 100}, {Start: 101, End: maxDate}})
+	if !c.Complete() || c.CoveredDays != maxDate+1 || len(c.Covered) != 1 {
+		t.Errorf("full coverage = %+v", c)
+	}
+	if g, n := c.LongestGap(); n != 0 || g != (DateRange{}) {
+		t.Errorf("LongestGap() = %v, %d; want zero", g, n)
+	}
+
+	c = CoverageByRanges(DateRange{Start: 5, End: 4}, []DateRange{{Start: 0, End: 10}})
+	if !c.Complete() || c.CoveredDays != 0 || c.GapDays != 0 {
+		t.Errorf("empty range coverage = %+v", c)
+	}
+}
+
+func TestCoverageBySet(t *testing.T) {
+	c := CoverageBySet(DateRange{Start: maxDate - 5, End: maxDate}, NewDateSet(maxDate-6, maxDate-4, maxDate-3, maxDate))
+	want := Coverage{
+		Covered:     []DateRange{{Start: maxDate - 4, End: maxDate - 3}, {Start: maxDate, End: maxDate}},
+		Gaps:        []DateRange{{Start: maxDate - 5, End: maxDate - 5}, {Start: maxDate - 2, End: maxDate - 1}},
+		CoveredDays: 3,
+		GapDays:     3,
+	}
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("CoverageBySet = %+v; want %+v", c, want)
+	}
+}
+
+func TestCoverageByRanges_open(t *testing.T) {
+	year := DateRange{Start: MustParseRFC("2024-01-01"), End: MustParseRFC("2024-12-31")}
+	c := CoverageByRanges(year, []DateRange{
+		RangeThrough(MustParseRFC("2024-01-31")),
+		RangeFrom(MustParseRFC("2024-06-01")),
+	})
+	var got []string
+	for _, v := range c.Covered {
+		got = append(got, v.String())
+	}
+	if want := []string{"2024-01-01/2024-01-31", "2024-06-01/2024-12-31"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Covered = %q; want %q", got, want)
+	}
+
+	// Open sides of the window itself remain open.
+	c = CoverageByRanges(RangeFrom(MustParseRFC("2024-01-01")), []DateRange{RangeFrom(MustParseRFC("2024-06-01"))})
+	if len(c.Covered) != 1 || c.Covered[0].String() != "2024-06-01/.." {
+		t.Errorf("Covered = %v; want 2024-06-01/..", c.Covered)
+	}
+}
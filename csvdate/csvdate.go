@@ -0,0 +1,179 @@
+// Package csvdate converts designated columns of CSV records between
+// strings and dates, while streaming them with encoding/csv. Columns are
+// identified by zero-based index, and each has its own layout:
+//
+//	r := csvdate.NewReader(csv.NewReader(f), map[int]string{
+//		0: epochdate.RFC3339,
+//		3: "01/02/2006",
+//	})
+//	for {
+//		record, dates, err := r.Read()
+//		...
+//	}
+//
+// Header rows should be read from the underlying csv.Reader, or written to
+// the underlying csv.Writer, directly.
+//
+package csvdate
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/xtgo/epochdate"
+)
+
+var errMissing = errors.New("missing date column")
+
+// ParseError describes a date which could not be parsed. Line and Column
+// are 1-based, and Column counts fields, unlike csv.ParseError, which counts
+// bytes, since the fields of date columns are what the user can correct.
+//
+type ParseError struct {
+	Line   int
+	Column int
+	Value  string
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	if e.Err == errMissing {
+		return fmt.Sprintf("csvdate: line %d: %v %d", e.Line, e.Err, e.Column)
+	}
+	return fmt.Sprintf("csvdate: line %d, column %d: %q: %v", e.Line, e.Column, e.Value, e.Err)
+}
+
+// Unwrap returns e.Err.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// column is a designated date column.
+type column struct {
+	index  int
+	layout string
+}
+
+// columns returns the columns designated by layouts, in order.
+func columns(layouts map[int]string) []column {
+	cols := make([]column, 0, len(layouts))
+	for i, l := range layouts {
+		if l == "" {
+			l = epochdate.RFC3339
+		}
+		cols = append(cols, column{i, l})
+	}
+	sort.Slice(cols, func(i, j int) bool { return cols[i].index < cols[j].index })
+	return cols
+}
+
+// Reader reads records from a csv.Reader, parsing the dates in designated
+// columns.
+type Reader struct {
+	// AllowEmpty permits empty fields in date columns, which yield the zero
+	// Date, rather than causing errors.
+	AllowEmpty bool
+
+	r     *csv.Reader
+	cols  []column
+	lines lineTracker
+}
+
+// NewReader returns a Reader which reads from r, parsing the dates in the
+// columns which are keys of layouts. An empty layout means
+// epochdate.RFC3339.
+//
+func NewReader(r *csv.Reader, layouts map[int]string) *Reader {
+	return &Reader{r: r, cols: columns(layouts)}
+}
+
+// Read reads the next record. The returned dates slice has the same length
+// as the record, and holds the parsed dates of the designated columns, and
+// zero elsewhere. At the end of input, Read returns io.EOF.
+//
+// If a date cannot be parsed, Read returns the record, the dates parsed so
+// far, and a *ParseError for the leftmost such column; subsequent calls
+// continue with the next record. A
+// record missing a designated column is reported as a *ParseError with the
+// missing column, and an empty Value.
+//
+func (r *Reader) Read() (record []string, dates []epochdate.Date, err error) {
+	record, err = r.r.Read()
+	if err != nil {
+		return record, nil, err
+	}
+	r.lines.record(record)
+	dates = make([]epochdate.Date, len(record))
+	for _, c := range r.cols {
+		if err := r.parse(record, dates, c); err != nil {
+			return record, dates, err
+		}
+	}
+	return record, dates, nil
+}
+
+func (r *Reader) parse(record []string, dates []epochdate.Date, c column) error {
+	i := c.index
+	if i >= len(record) {
+		return &ParseError{Line: r.lines.line(r.r, record, 0), Column: i + 1, Err: errMissing}
+	}
+	s := record[i]
+	if s == "" && r.AllowEmpty {
+		return nil
+	}
+	d, err := epochdate.Parse(c.layout, s)
+	if err != nil {
+		return &ParseError{Line: r.lines.line(r.r, record, i), Column: i + 1, Value: s, Err: err}
+	}
+	dates[i] = d
+	return nil
+}
+
+// Writer writes records to a csv.Writer, formatting the dates of
+// designated columns.
+type Writer struct {
+	w    *csv.Writer
+	cols []column
+	buf  []string
+}
+
+// NewWriter returns a Writer which writes to w, formatting the dates in the
+// columns which are keys of layouts. An empty layout means
+// epochdate.RFC3339.
+//
+func NewWriter(w *csv.Writer, layouts map[int]string) *Writer {
+	return &Writer{w: w, cols: columns(layouts)}
+}
+
+// Write writes a record, replacing the field of each designated column with
+// the corresponding element of dates, formatted. Neither record nor dates is
+// modified, and they must have the same length. As with csv.Writer, output
+// is buffered until Flush is called.
+//
+func (w *Writer) Write(record []string, dates []epochdate.Date) error {
+	if len(dates) != len(record) {
+		return fmt.Errorf("csvdate: record has %d fields but %d dates", len(record), len(dates))
+	}
+	w.buf = append(w.buf[:0], record...)
+	for _, c := range w.cols {
+		if c.index >= len(w.buf) {
+			return fmt.Errorf("csvdate: record has %d fields, missing date column %d", len(record), c.index+1)
+		}
+		w.buf[c.index] = dates[c.index].Format(c.layout)
+	}
+	return w.w.Write(w.buf)
+}
+
+// Flush writes any buffered data to the underlying writer.
+func (w *Writer) Flush() {
+	w.w.Flush()
+}
+
+// Error reports any error that has occurred during a previous Write or
+// Flush.
+//
+func (w *Writer) Error() error {
+	return w.w.Error()
+}
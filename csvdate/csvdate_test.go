@@ -0,0 +1,101 @@
+package csvdate
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/xtgo/epochdate"
+)
+
+func TestReader(t *testing.T) {
+	const input = `id,due,paid
+1,2024-03-01,03/15/2024
+2,2024-04-01,
+3,2024-04-31,x
+4,2024-05-01
+`
+	cr := csv.NewReader(strings.NewReader(input))
+	cr.FieldsPerRecord = -1
+	if _, err := cr.Read(); err != nil {
+		t.Fatal(err)
+	}
+	r := NewReader(cr, map[int]string{1: "", 2: "01/02/2006"})
+	r.AllowEmpty = true
+
+	d := epochdate.MustParseRFC
+	want := []struct {
+		dates []epochdate.Date
+		err   string
+	}{
+		{[]epochdate.Date{0, d("2024-03-01"), d("2024-03-15")}, ""},
+		{[]epochdate.Date{0, d("2024-04-01"), 0}, ""},
+		{[]epochdate.Date{0, 0, 0}, `csvdate: line 4, column 2: "2024-04-31": parsing time "2024-04-31": day out of range`},
+		{nil, "csvdate: line 5: missing date column 3"},
+	}
+	for i, w := range want {
+		_, dates, err := r.Read()
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		if msg != w.err {
+			t.Errorf("record %d: error = %q, want %q", i, msg, w.err)
+		}
+		if w.dates != nil && !equal(dates, w.dates) {
+			t.Errorf("record %d: dates = %v, want %v", i, dates, w.dates)
+		}
+	}
+	if _, _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read at end = %v, want EOF", err)
+	}
+
+	r = NewReader(csv.NewReader(strings.NewReader("1,\n")), map[int]string{1: ""})
+	_, _, err := r.Read()
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Line != 1 || perr.Column != 2 {
+		t.Errorf("empty field error = %v, want *ParseError at 1:2", err)
+	}
+}
+
+func TestWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewWriter(csv.NewWriter(&b), map[int]string{1: "", 2: "Jan 2, 2006"})
+
+	d := epochdate.MustParseRFC("2024-03-01")
+	record := []string{"1", "", "ignored"}
+	if err := w.Write(record, []epochdate.Date{0, d, d + 14}); err != nil {
+		t.Fatal(err)
+	}
+	if record[2] != "ignored" {
+		t.Error("Write modified record")
+	}
+	if err := w.Write([]string{"2"}, []epochdate.Date{0}); err == nil {
+		t.Error("Write of short record succeeded")
+	}
+	if err := w.Write([]string{"2", "", ""}, nil); err == nil {
+		t.Error("Write with missing dates succeeded")
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "1,2024-03-01,\"Mar 15, 2024\"\n"; b.String() != want {
+		t.Errorf("output = %q, want %q", b.String(), want)
+	}
+}
+
+func equal(a, b []epochdate.Date) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+//go:build !go1.17
+// +build !go1.17
+
+package csvdate
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+// lineTracker finds the line numbers of fields read from a csv.Reader by
+// counting the lines of each record, since csv.Reader.FieldPos requires Go
+// 1.17. The count begins at line 1 with the first record read through the
+// Reader, so it does not include records read from the csv.Reader before
+// it was wrapped, nor the blank lines which csv.Reader skips.
+//
+type lineTracker struct {
+	start, next int
+}
+
+// record notes that rec was read, so that its lines are counted.
+func (t *lineTracker) record(rec []string) {
+	if t.next == 0 {
+		t.next = 1
+	}
+	t.start = t.next
+	for _, f := range rec {
+		t.next += strings.Count(f, "\n")
+	}
+	t.next++
+}
+
+// line returns the line on which field i of the last record read from r
+// begins.
+//
+func (t *lineTracker) line(r *csv.Reader, rec []string, i int) int {
+	line := t.start
+	for _, f := range rec[:i] {
+		line += strings.Count(f, "\n")
+	}
+	return line
+}
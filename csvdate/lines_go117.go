@@ -0,0 +1,21 @@
+//go:build go1.17
+// +build go1.17
+
+package csvdate
+
+import "encoding/csv"
+
+// lineTracker finds the line numbers of fields read from a csv.Reader,
+// using csv.Reader.FieldPos.
+type lineTracker struct{}
+
+// record notes that rec was read. It is unnecessary with FieldPos.
+func (*lineTracker) record(rec []string) {}
+
+// line returns the line on which field i of the last record read from r
+// begins.
+//
+func (*lineTracker) line(r *csv.Reader, rec []string, i int) int {
+	line, _ := r.FieldPos(i)
+	return line
+}
@@ -0,0 +1,45 @@
+package epochdate
+
+import "time"
+
+// Date32 is a number of days since 1970-01-01, which may be negative, with
+// the same meaning as the date32 type of Arrow and Parquet. Its range of
+// roughly 5.8 million years either side of 1970 suits archives and
+// interchange, while Date remains the compact form for hot paths; the
+// conversions between them fail loudly rather than wrapping.
+//
+type Date32 int32
+
+// ToDate32 returns d as a Date32, which is always lossless.
+func (d Date) ToDate32() Date32 {
+	return Date32(d)
+}
+
+// ToDate returns d32 as a Date, or ErrOutOfRange if it is outside the range
+// of Date, regardless of Clamp. Use ClampToDate where clamping is intended.
+//
+func (d32 Date32) ToDate() (Date, error) {
+	if d32 < 0 || d32 > maxDate {
+		return 0, ErrOutOfRange
+	}
+	return Date(d32), nil
+}
+
+// ClampToDate behaves like ToDate, except that it clamps out-of-range values
+// to the nearest representable Date rather than returning an error.
+//
+func (d32 Date32) ClampToDate() Date {
+	return ClampFromInt32(int32(d32))
+}
+
+// UTC returns a UTC Time object set to 00:00:00 on the given date.
+func (d32 Date32) UTC() time.Time {
+	return time.Unix(int64(d32)*day, 0).UTC()
+}
+
+// String returns the date in the form "2006-01-02". Years outside the range
+// [0,9999] are formatted as by time.Time.Format.
+//
+func (d32 Date32) String() string {
+	return d32.UTC().Format(RFC3339)
+}
@@ -0,0 +1,38 @@
+package epochdate
+
+import "testing"
+
+func TestDate32(t *testing.T) {
+	defer func(c bool) { Clamp = c }(Clamp)
+	Clamp = true
+
+	for _, d := range []Date{0, 19783, maxDate} {
+		d32 := d.ToDate32()
+		if got, err := d32.ToDate(); err != nil || got != d {
+			t.Errorf("%v round trip = %v, %v", d, got, err)
+		}
+		if d32.String() != d.String() {
+			t.Errorf("Date32 String() = %s; want %s", d32, d)
+		}
+	}
+
+	tests := []struct {
+		d32     Date32
+		clamped Date
+		str     string
+	}{
+		{-1, 0, "1969-12-31"},
+		{maxDate + 1, maxDate, "2149-06-07"},
+	}
+	for _, tt := range tests {
+		if d, err := tt.d32.ToDate(); err != ErrOutOfRange {
+			t.Errorf("Date32(%d).ToDate() = %v, %v; want ErrOutOfRange", tt.d32, d, err)
+		}
+		if d := tt.d32.ClampToDate(); d != tt.clamped {
+			t.Errorf("Date32(%d).ClampToDate() = %v; want %v", tt.d32, d, tt.clamped)
+		}
+		if s := tt.d32.String(); s != tt.str {
+			t.Errorf("Date32(%d).String() = %s; want %s", tt.d32, s, tt.str)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+// Command datecheck reports raw arithmetic on epochdate.Date and
+// epochdate.YearMonth values, and comparisons of such values against bare
+// integer literals. See package github.com/xtgo/epochdate/datecheck for
+// details.
+//
+// Usage:
+//
+//	datecheck [flags] packages...
+//
+// It may also be run via go vet:
+//
+//	go vet -vettool=$(which datecheck) packages...
+//
+package main
+
+import (
+	"github.com/xtgo/epochdate/datecheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(datecheck.Analyzer)
+}
@@ -0,0 +1,149 @@
+// Package datecheck defines an Analyzer that reports raw integer arithmetic
+// on epochdate.Date and epochdate.YearMonth values, and comparisons of such
+// values against bare integer literals.
+//
+// Date and YearMonth are uint16 values, so arithmetic such as d+1 silently
+// wraps around at the ends of the representable range, and d-other
+// produces a meaningless result whenever other is after d. Comparing against
+// an integer literal, as in d < 19000, obscures which date is intended.
+// Code should instead use the methods of those types, or compare against
+// values constructed from dates, such as epochdate.MustParseRFC("2022-01-08").
+//
+package datecheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const epochdatePath = "github.com/xtgo/epochdate"
+
+// Analyzer reports misuse of epochdate.Date and epochdate.YearMonth values.
+var Analyzer = &analysis.Analyzer{
+	Name:     "datecheck",
+	Doc:      "report raw arithmetic on epochdate values and comparisons against integer literals",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// dateType returns the name of the epochdate type of e, or the empty string
+// if e does not have an epochdate type.
+//
+func dateType(pass *analysis.Pass, e ast.Expr) string {
+	named, ok := pass.TypesInfo.TypeOf(e).(*types.Named)
+	if !ok {
+		return ""
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != epochdatePath {
+		return ""
+	}
+	switch obj.Name() {
+	case "Date", "YearMonth":
+		return "epochdate." + obj.Name()
+	}
+	return ""
+}
+
+// isIntLiteral reports whether e is an integer literal, possibly negated or
+// parenthesized.
+//
+func isIntLiteral(e ast.Expr) bool {
+	for {
+		switch v := e.(type) {
+		case *ast.ParenExpr:
+			e = v.X
+		case *ast.UnaryExpr:
+			if v.Op != token.SUB && v.Op != token.ADD {
+				return false
+			}
+			e = v.X
+		case *ast.BasicLit:
+			return v.Kind == token.INT
+		default:
+			return false
+		}
+	}
+}
+
+func isArithmetic(op token.Token) bool {
+	switch op {
+	case token.ADD, token.SUB, token.MUL, token.QUO, token.REM,
+		token.AND, token.OR, token.XOR, token.SHL, token.SHR, token.AND_NOT:
+		return true
+	}
+	return false
+}
+
+func isComparison(op token.Token) bool {
+	switch op {
+	case token.EQL, token.NEQ, token.LSS, token.LEQ, token.GTR, token.GEQ:
+		return true
+	}
+	return false
+}
+
+// compoundOps maps assignment operators to their arithmetic operators.
+var compoundOps = map[token.Token]token.Token{
+	token.ADD_ASSIGN:     token.ADD,
+	token.SUB_ASSIGN:     token.SUB,
+	token.MUL_ASSIGN:     token.MUL,
+	token.QUO_ASSIGN:     token.QUO,
+	token.REM_ASSIGN:     token.REM,
+	token.AND_ASSIGN:     token.AND,
+	token.OR_ASSIGN:      token.OR,
+	token.XOR_ASSIGN:     token.XOR,
+	token.SHL_ASSIGN:     token.SHL,
+	token.SHR_ASSIGN:     token.SHR,
+	token.AND_NOT_ASSIGN: token.AND_NOT,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	// the epochdate package itself necessarily operates on the raw values.
+	if pass.Pkg.Path() == epochdatePath {
+		return nil, nil
+	}
+
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.BinaryExpr)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.IncDecStmt)(nil),
+	}
+	insp.Preorder(nodeFilter, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.BinaryExpr:
+			typ := dateType(pass, n.X)
+			if typ == "" {
+				typ = dateType(pass, n.Y)
+			}
+			switch {
+			case typ == "":
+			case isArithmetic(n.Op):
+				pass.Reportf(n.OpPos, "raw %s arithmetic on %s may silently wrap around", n.Op, typ)
+			case isComparison(n.Op) && (isIntLiteral(n.X) || isIntLiteral(n.Y)):
+				pass.Reportf(n.OpPos, "comparison of %s with integer literal obscures the intended date", typ)
+			}
+
+		case *ast.AssignStmt:
+			op, ok := compoundOps[n.Tok]
+			if !ok || len(n.Lhs) != 1 {
+				return
+			}
+			if typ := dateType(pass, n.Lhs[0]); typ != "" {
+				pass.Reportf(n.TokPos, "raw %s arithmetic on %s may silently wrap around", op, typ)
+			}
+
+		case *ast.IncDecStmt:
+			if typ := dateType(pass, n.X); typ != "" {
+				pass.Reportf(n.TokPos, "raw %s on %s may silently wrap around", n.Tok, typ)
+			}
+		}
+	})
+	return nil, nil
+}
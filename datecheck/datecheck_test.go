@@ -0,0 +1,12 @@
+package datecheck_test
+
+import (
+	"testing"
+
+	"github.com/xtgo/epochdate/datecheck"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), datecheck.Analyzer, "a", "github.com/xtgo/epochdate")
+}
@@ -0,0 +1,22 @@
+package a
+
+import "github.com/xtgo/epochdate"
+
+type days uint16
+
+func f(d, other epochdate.Date, ym epochdate.YearMonth, n days) {
+	_ = d + 1     // want `raw \+ arithmetic on epochdate.Date may silently wrap around`
+	_ = d - other // want `raw - arithmetic on epochdate.Date may silently wrap around`
+	_ = 2 * ym    // want `raw \* arithmetic on epochdate.YearMonth may silently wrap around`
+	_ = ym % 12   // want `raw % arithmetic on epochdate.YearMonth may silently wrap around`
+	d += 7        // want `raw \+ arithmetic on epochdate.Date may silently wrap around`
+	d++           // want `raw \+\+ on epochdate.Date may silently wrap around`
+	ym--          // want `raw -- on epochdate.YearMonth may silently wrap around`
+	_ = d < 19000 // want `comparison of epochdate.Date with integer literal obscures the intended date`
+	_ = (0) == ym // want `comparison of epochdate.YearMonth with integer literal obscures the intended date`
+	_ = d > epochdate.Date(19000)
+	_ = d == other
+	_ = int(d) - int(other)
+	_ = n + 1
+	d = other
+}
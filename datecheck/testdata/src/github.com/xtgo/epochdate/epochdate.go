@@ -0,0 +1,9 @@
+package epochdate
+
+type Date uint16
+
+type YearMonth uint16
+
+func (d Date) next() Date {
+	return d + 1
+}
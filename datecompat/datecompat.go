@@ -0,0 +1,108 @@
+// Package datecompat eases incremental migration to epochdate from other
+// date libraries, such as github.com/rickb777/date and
+// github.com/fxtlabs/date, and from github.com/jinzhu/now, without
+// depending on any of them.
+//
+// Date types of those libraries, like epochdate.Date, have a Date method
+// returning the year, month, and day, so values convert in either direction
+// through those components:
+//
+//	d, err := datecompat.FromYMD(legacy)   // to epochdate
+//	legacy := date.New(d.Date())            // from epochdate
+//
+// The period boundary functions correspond to those of jinzhu/now, such as
+// now.BeginningOfMonth, but operate on dates rather than times, so that the
+// end of a period is its last date, rather than its last nanosecond.
+// Boundaries beyond the representable range are clamped to it.
+//
+package datecompat
+
+import (
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// YMDer is implemented by date types of other libraries, and by time.Time.
+type YMDer interface {
+	Date() (year int, month time.Month, day int)
+}
+
+// FromYMD returns the Date with the same components as v. Out-of-range
+// dates are clamped or yield epochdate.ErrOutOfRange, according to
+// epochdate.Clamp.
+//
+func FromYMD(v YMDer) (epochdate.Date, error) {
+	return epochdate.NewFromDate(v.Date())
+}
+
+// BeginningOfWeek returns the first date of the week containing d, where
+// weeks begin on firstDay, like now.BeginningOfWeek with now.WeekStartDay.
+//
+func BeginningOfWeek(d epochdate.Date, firstDay time.Weekday) epochdate.Date {
+	n := (int(d.UTC().Weekday()) - int(firstDay) + 7) % 7
+	return add(d, -n)
+}
+
+// EndOfWeek returns the last date of the week containing d, where weeks
+// begin on firstDay.
+//
+func EndOfWeek(d epochdate.Date, firstDay time.Weekday) epochdate.Date {
+	n := (int(d.UTC().Weekday()) - int(firstDay) + 7) % 7
+	return add(d, 6-n)
+}
+
+// BeginningOfMonth returns the first date of the month containing d.
+func BeginningOfMonth(d epochdate.Date) epochdate.Date {
+	y, m, _ := d.Date()
+	return epochdate.ClampFromDate(y, m, 1)
+}
+
+// EndOfMonth returns the last date of the month containing d.
+func EndOfMonth(d epochdate.Date) epochdate.Date {
+	y, m, _ := d.Date()
+	return epochdate.ClampFromDate(y, m+1, 0)
+}
+
+// BeginningOfQuarter returns the first date of the calendar quarter
+// containing d.
+//
+func BeginningOfQuarter(d epochdate.Date) epochdate.Date {
+	y, m, _ := d.Date()
+	return epochdate.ClampFromDate(y, m-(m-1)%3, 1)
+}
+
+// EndOfQuarter returns the last date of the calendar quarter containing d.
+func EndOfQuarter(d epochdate.Date) epochdate.Date {
+	y, m, _ := d.Date()
+	return epochdate.ClampFromDate(y, m-(m-1)%3+3, 0)
+}
+
+// BeginningOfHalf returns the first date of the half year containing d.
+func BeginningOfHalf(d epochdate.Date) epochdate.Date {
+	y, m, _ := d.Date()
+	return epochdate.ClampFromDate(y, m-(m-1)%6, 1)
+}
+
+// EndOfHalf returns the last date of the half year containing d.
+func EndOfHalf(d epochdate.Date) epochdate.Date {
+	y, m, _ := d.Date()
+	return epochdate.ClampFromDate(y, m-(m-1)%6+6, 0)
+}
+
+// BeginningOfYear returns the first date of the year containing d.
+func BeginningOfYear(d epochdate.Date) epochdate.Date {
+	y, _, _ := d.Date()
+	return epochdate.ClampFromDate(y, time.January, 1)
+}
+
+// EndOfYear returns the last date of the year containing d.
+func EndOfYear(d epochdate.Date) epochdate.Date {
+	y, _, _ := d.Date()
+	return epochdate.ClampFromDate(y, time.December, 31)
+}
+
+// add returns d plus n days, clamped to the representable range.
+func add(d epochdate.Date, n int) epochdate.Date {
+	return epochdate.ClampFromUnix((int64(d) + int64(n)) * 24 * 60 * 60)
+}
@@ -0,0 +1,60 @@
+package datecompat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// legacyDate mimics the date types of other libraries, which expose their
+// components through a Date method.
+type legacyDate struct{ t time.Time }
+
+func (d legacyDate) Date() (int, time.Month, int) { return d.t.Date() }
+
+func TestFromYMD(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	for _, v := range []YMDer{
+		legacyDate{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		time.Date(2024, 3, 1, 23, 0, 0, 0, time.FixedZone("", -5*60*60)),
+		want,
+	} {
+		if d, err := FromYMD(v); err != nil || d != want {
+			t.Errorf("FromYMD(%v) = %v, %v; want %v", v, d, err, want)
+		}
+	}
+	if d, err := FromYMD(legacyDate{time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)}); err == nil {
+		t.Errorf("FromYMD(1969-12-31) = %v, want error", d)
+	}
+}
+
+func TestBoundaries(t *testing.T) {
+	d := epochdate.MustParseRFC
+	tests := []struct {
+		name string
+		fn   func(epochdate.Date) epochdate.Date
+		in   string
+		want string
+	}{
+		{"BeginningOfWeek/Sunday", func(d epochdate.Date) epochdate.Date { return BeginningOfWeek(d, time.Sunday) }, "2024-03-01", "2024-02-25"},
+		{"BeginningOfWeek/Monday", func(d epochdate.Date) epochdate.Date { return BeginningOfWeek(d, time.Monday) }, "2024-03-04", "2024-03-04"},
+		{"EndOfWeek/Monday", func(d epochdate.Date) epochdate.Date { return EndOfWeek(d, time.Monday) }, "2024-03-01", "2024-03-03"},
+		{"BeginningOfWeek/clamped", func(d epochdate.Date) epochdate.Date { return BeginningOfWeek(d, time.Monday) }, "1970-01-01", "1970-01-01"},
+		{"EndOfWeek/clamped", func(d epochdate.Date) epochdate.Date { return EndOfWeek(d, time.Monday) }, "2149-06-06", "2149-06-06"},
+		{"BeginningOfMonth", BeginningOfMonth, "2024-03-15", "2024-03-01"},
+		{"EndOfMonth", EndOfMonth, "2024-02-10", "2024-02-29"},
+		{"BeginningOfQuarter", BeginningOfQuarter, "2024-06-30", "2024-04-01"},
+		{"EndOfQuarter", EndOfQuarter, "2024-11-01", "2024-12-31"},
+		{"BeginningOfHalf", BeginningOfHalf, "2024-12-31", "2024-07-01"},
+		{"EndOfHalf", EndOfHalf, "2024-01-01", "2024-06-30"},
+		{"BeginningOfYear", BeginningOfYear, "2024-07-04", "2024-01-01"},
+		{"EndOfYear", EndOfYear, "2024-07-04", "2024-12-31"},
+		{"EndOfMonth/clamped", EndOfMonth, "2149-06-01", "2149-06-06"},
+	}
+	for _, tt := range tests {
+		if got := tt.fn(d(tt.in)); got != d(tt.want) {
+			t.Errorf("%s(%s) = %v, want %s", tt.name, tt.in, got, tt.want)
+		}
+	}
+}
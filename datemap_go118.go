@@ -0,0 +1,108 @@
+//go:build go1.18
+// +build go1.18
+
+package epochdate
+
+import "sort"
+
+// DateMap is a map keyed by Date which maintains its keys in order, so that
+// it can answer queries such as "the most recent rate on or before a date"
+// using Floor. It is backed by a sorted slice, so lookups take logarithmic
+// time, while insertions and deletions take linear time; it suits data
+// which is loaded once and queried often. The zero value is an empty map,
+// ready to use.
+//
+type DateMap[V any] struct {
+	keys   []Date
+	values []V
+}
+
+// search returns the index of the first key on or after d.
+func (m *DateMap[V]) search(d Date) int {
+	return sort.Search(len(m.keys), func(i int) bool { return m.keys[i] >= d })
+}
+
+// Set associates v with d, replacing any existing value.
+func (m *DateMap[V]) Set(d Date, v V) {
+	i := m.search(d)
+	if i < len(m.keys) && m.keys[i] == d {
+		m.values[i] = v
+		return
+	}
+	var zero V
+	m.keys = append(m.keys, 0)
+	m.values = append(m.values, zero)
+	copy(m.keys[i+1:], m.keys[i:])
+	copy(m.values[i+1:], m.values[i:])
+	m.keys[i], m.values[i] = d, v
+}
+
+// Get returns the value associated with d, and whether there is one.
+func (m *DateMap[V]) Get(d Date) (V, bool) {
+	i := m.search(d)
+	if i < len(m.keys) && m.keys[i] == d {
+		return m.values[i], true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes any value associated with d.
+func (m *DateMap[V]) Delete(d Date) {
+	i := m.search(d)
+	if i < len(m.keys) && m.keys[i] == d {
+		m.keys = append(m.keys[:i], m.keys[i+1:]...)
+		copy(m.values[i:], m.values[i+1:])
+		var zero V
+		m.values[len(m.values)-1] = zero // release for garbage collection
+		m.values = m.values[:len(m.values)-1]
+	}
+}
+
+// Len returns the number of dates in the map.
+func (m *DateMap[V]) Len() int {
+	return len(m.keys)
+}
+
+// Floor returns the latest date on or before d which is in the map, along
+// with its value. The boolean result is false if there is no such date.
+//
+func (m *DateMap[V]) Floor(d Date) (Date, V, bool) {
+	i := m.search(d)
+	if i < len(m.keys) && m.keys[i] == d {
+		return m.keys[i], m.values[i], true
+	}
+	if i == 0 {
+		var zero V
+		return 0, zero, false
+	}
+	return m.keys[i-1], m.values[i-1], true
+}
+
+// Ceiling returns the earliest date on or after d which is in the map, along
+// with its value. The boolean result is false if there is no such date.
+//
+func (m *DateMap[V]) Ceiling(d Date) (Date, V, bool) {
+	i := m.search(d)
+	if i == len(m.keys) {
+		var zero V
+		return 0, zero, false
+	}
+	return m.keys[i], m.values[i], true
+}
+
+// Keys returns the dates in the map in ascending order.
+func (m *DateMap[V]) Keys() []Date {
+	return append([]Date(nil), m.keys...)
+}
+
+// Range calls fn for each date in r which is in the map, in ascending order,
+// stopping early if fn returns false. The map must not be modified by fn.
+//
+func (m *DateMap[V]) Range(r DateRange, fn func(d Date, v V) bool) {
+	for i := m.search(r.Start); i < len(m.keys) && m.keys[i] <= r.End; i++ {
+		if !fn(m.keys[i], m.values[i]) {
+			return
+		}
+	}
+}
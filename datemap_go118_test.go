@@ -0,0 +1,92 @@
+//go:build go1.18
+// +build go1.18
+
+package epochdate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDateMap(t *testing.T) {
+	var m DateMap[int]
+	for _, d := range []Date{30, 10, 20, 10, 40} {
+		m.Set(d, int(d))
+	}
+	m.Set(20, 21)
+	m.Delete(40)
+	m.Delete(41)
+
+	if got, want := m.Keys(), []Date{10, 20, 30}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Keys() = %v; want %v", got, want)
+	}
+	if v, ok := m.Get(20); v != 21 || !ok {
+		t.Errorf("Get(20) = %d, %v; want 21, true", v, ok)
+	}
+	if v, ok := m.Get(25); v != 0 || ok {
+		t.Errorf("Get(25) = %d, %v; want 0, false", v, ok)
+	}
+
+	tests := []struct {
+		d           Date
+		floor, ceil Date
+		fok, cok    bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{15, 10, 20, true, true},
+		{30, 30, 30, true, true},
+		{35, 30, 0, true, false},
+	}
+	for _, tt := range tests {
+		if d, v, ok := m.Floor(tt.d); d != tt.floor || ok != tt.fok || (ok && v == 0) {
+			t.Errorf("Floor(%d) = %d, %d, %v; want %d, %v", tt.d, d, v, ok, tt.floor, tt.fok)
+		}
+		if d, v, ok := m.Ceiling(tt.d); d != tt.ceil || ok != tt.cok || (ok && v == 0) {
+			t.Errorf("Ceiling(%d) = %d, %d, %v; want %d, %v", tt.d, d, v, ok, tt.ceil, tt.cok)
+		}
+	}
+
+	var got []int
+	m.Range(DateRange{Start: 15, End: maxDate}, func(d Date, v int) bool {
+		got = append(got, v)
+		return true
+	})
+	if want := []int{21, 30}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range values = %v; want %v", got, want)
+	}
+	got = nil
+	m.Range(DateRange{Start: 0, End: 30}, func(d Date, v int) bool {
+		got = append(got, v)
+		return len(got) < 2
+	})
+	if want := []int{10, 21}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Range with early stop = %v; want %v", got, want)
+	}
+	if m.Len() != 3 {
+		t.Errorf("Len() = %d; want 3", m.Len())
+	}
+}
+
+func TestDateMap_DeleteKeepsValues(t *testing.T) {
+	for _, del := range []Date{1, 2, 3} {
+		var m DateMap[string]
+		want := map[Date]string{1: "a", 2: "b", 3: "c"}
+		for d, v := range want {
+			m.Set(d, v)
+		}
+		m.Delete(del)
+		delete(want, del)
+		if m.Len() != len(want) {
+			t.Errorf("after Delete(%d), Len() = %d; want %d", del, m.Len(), len(want))
+		}
+		for d, v := range want {
+			if got, ok := m.Get(d); !ok || got != v {
+				t.Errorf("after Delete(%d), Get(%d) = %q, %v; want %q, true", del, d, got, ok, v)
+			}
+		}
+		if _, ok := m.Get(del); ok {
+			t.Errorf("after Delete(%d), the date is still present", del)
+		}
+	}
+}
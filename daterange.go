@@ -0,0 +1,275 @@
+package epochdate
+
+import (
+	"errors"
+	"strings"
+)
+
+// DateRange is an inclusive range of dates, from Start through End. A range
+// whose End precedes its Start is empty.
+//
+// A range may be unbounded on either side, such as a contract in effect
+// from a date onward. Since no date precedes 1970-01-01 or follows
+// 2149-06-06, an unbounded range holds the extreme date on its open side,
+// so that it behaves as any other range, while the OpenStart and OpenEnd
+// fields record that the bound is absent, rather than coincidentally
+// extreme, for encoding. RangeFrom and RangeThrough construct such ranges.
+//
+type DateRange struct {
+	Start Date
+	End   Date
+
+	// OpenStart and OpenEnd are set if the range has no lower or upper
+	// bound, respectively, in which case Start must be 1970-01-01, or End
+	// must be 2149-06-06.
+	OpenStart bool
+	OpenEnd   bool
+}
+
+// RangeFrom returns the range of dates from start onward, without an upper
+// bound.
+//
+func RangeFrom(start Date) DateRange {
+	return DateRange{Start: start, End: maxDate, OpenEnd: true}
+}
+
+// RangeThrough returns the range of dates up to and including end, without
+// a lower bound.
+//
+func RangeThrough(end Date) DateRange {
+	return DateRange{Start: 0, End: end, OpenStart: true}
+}
+
+// openBound is the ISO 8601-2 notation for an open bound of an interval.
+const openBound = ".."
+
+var (
+	errDateRangeSyntax = errors.New(`epochdate: DateRange must be of the form "start/end", where each bound is a date or ".."`)
+	errDateRangeOpen   = errors.New("epochdate: open DateRange bounds must hold the extreme dates")
+	errDateRangeOrder  = errors.New("epochdate: DateRange end must not precede its start")
+)
+
+// String returns r as an ISO 8601 interval of dates, such as
+// "2024-01-01/2024-12-31", with open bounds written as "..", as in
+// "2024-01-01/..".
+//
+func (r DateRange) String() string {
+	start, end := r.Start.String(), r.End.String()
+	if r.OpenStart {
+		start = openBound
+	}
+	if r.OpenEnd {
+		end = openBound
+	}
+	return start + "/" + end
+}
+
+// ParseDateRange parses an ISO 8601 interval of RFC 3339 dates, in the form
+// returned by String, where either bound may be ".." to leave that side of
+// the range open. An error is returned if the end precedes the start, so
+// empty ranges, though String formats them, cannot be parsed.
+//
+func ParseDateRange(s string) (DateRange, error) {
+	var r DateRange
+	i := strings.IndexByte(s, '/')
+	if i < 0 {
+		return r, errDateRangeSyntax
+	}
+	start, end := s[:i], s[i+1:]
+	var err error
+	if start == openBound {
+		r.OpenStart = true
+	} else if r.Start, err = ParseRFC(start); err != nil {
+		return DateRange{}, err
+	}
+	if end == openBound {
+		r.End, r.OpenEnd = maxDate, true
+	} else if r.End, err = ParseRFC(end); err != nil {
+		return DateRange{}, err
+	}
+	if r.End < r.Start {
+		return DateRange{}, errDateRangeOrder
+	}
+	return r, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the form returned by
+// String.
+//
+func (r DateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the forms
+// parsed by ParseDateRange.
+//
+func (r *DateRange) UnmarshalText(data []byte) error {
+	v, err := ParseDateRange(string(data))
+	if err != nil {
+		return err
+	}
+	*r = v
+	return nil
+}
+
+// Validate returns an error if r is open on a side whose bound is not the
+// extreme date, or if r is empty because its End precedes its Start. Empty
+// ranges are returned by Intersect and Subtract, but are rejected here, so
+// that Validate may check ranges received as input, such as those accepted
+// by ParseDateRange.
+//
+func (r DateRange) Validate() error {
+	if r.OpenStart && r.Start != 0 || r.OpenEnd && r.End != maxDate {
+		return errDateRangeOpen
+	}
+	if r.End < r.Start {
+		return errDateRangeOrder
+	}
+	return nil
+}
+
+// Len returns the number of dates in r, which is 0 if r is empty.
+func (r DateRange) Len() int {
+	if r.End < r.Start {
+		return 0
+	}
+	return int(r.End) - int(r.Start) + 1
+}
+
+// Contains reports whether d is in r.
+func (r DateRange) Contains(d Date) bool {
+	return d >= r.Start && d <= r.End
+}
+
+// Overlaps reports whether r and other have any dates in common.
+func (r DateRange) Overlaps(other DateRange) bool {
+	return r.Len() > 0 && other.Len() > 0 && r.Start <= other.End && other.Start <= r.End
+}
+
+// Intersect returns the dates in both r and other, which is an empty range
+// if they do not overlap. A side of the result is open only if that side of
+// both r and other is open.
+//
+func (r DateRange) Intersect(other DateRange) DateRange {
+	if !r.Overlaps(other) {
+		return emptyRange
+	}
+	v := r
+	if other.Start > v.Start {
+		v.Start = other.Start
+	}
+	if other.End < v.End {
+		v.End = other.End
+	}
+	v.OpenStart = r.OpenStart && other.OpenStart
+	v.OpenEnd = r.OpenEnd && other.OpenEnd
+	return v
+}
+
+// Union returns the dates in either r or other, and true, if they overlap
+// or are adjacent, so that the union is a single range. Otherwise, it
+// returns an empty range and false. The union with an empty range is the
+// other range. A side of the result is open if that side of either r or
+// other is open.
+//
+func (r DateRange) Union(other DateRange) (DateRange, bool) {
+	switch {
+	case other.Len() == 0:
+		return r, true
+	case r.Len() == 0:
+		return other, true
+	case int(other.Start) > int(r.End)+1 || int(r.Start) > int(other.End)+1:
+		return emptyRange, false
+	}
+	v := r
+	if other.Start < v.Start {
+		v.Start = other.Start
+	}
+	if other.End > v.End {
+		v.End = other.End
+	}
+	v.OpenStart = r.OpenStart || other.OpenStart
+	v.OpenEnd = r.OpenEnd || other.OpenEnd
+	return v, true
+}
+
+// Iter returns an iterator over the dates in r, in order:
+//
+//	it := r.Iter()
+//	for d, ok := it.Next(); ok; d, ok = it.Next() {
+//		...
+//	}
+//
+func (r DateRange) Iter() *DateRangeIterator {
+	return &DateRangeIterator{next: int(r.Start), end: int(r.End)}
+}
+
+// DateRangeIterator enumerates the dates in a range. Unlike a loop over
+// Date values, it does not wrap around at the end of the representable
+// range.
+//
+type DateRangeIterator struct {
+	next, end int
+}
+
+// Next returns the next date in the range, and true, or false if there are
+// no more dates.
+//
+func (it *DateRangeIterator) Next() (Date, bool) {
+	if it.next > it.end {
+		return 0, false
+	}
+	d := Date(it.next)
+	it.next++
+	return d, true
+}
+
+// emptyRange is the canonical empty range returned by DateRange methods.
+var emptyRange = DateRange{Start: 1, End: 0}
+
+// Subtract returns the parts of r which are not in other, in chronological
+// order. There may be zero, one, or two parts, the latter when other lies
+// strictly within r. No parts are empty, and parts on the open sides of r
+// remain open.
+//
+func (r DateRange) Subtract(other DateRange) []DateRange {
+	if r.Len() == 0 {
+		return nil
+	}
+	if other.Len() == 0 || other.End < r.Start || other.Start > r.End {
+		return []DateRange{r}
+	}
+	var parts []DateRange
+	if other.Start > r.Start {
+		parts = append(parts, DateRange{Start: r.Start, End: other.Start - 1, OpenStart: r.OpenStart})
+	}
+	if other.End < r.End {
+		parts = append(parts, DateRange{Start: other.End + 1, End: r.End, OpenEnd: r.OpenEnd})
+	}
+	return parts
+}
+
+// SplitAt divides r into the dates before d and the dates from d onward, as
+// when prorating a subscription which changes on d. Either part may be
+// empty, such as the first part if d is on or before r.Start. The parts
+// keep the open bounds of r on their outer sides.
+//
+func (r DateRange) SplitAt(d Date) (before, after DateRange) {
+	before, after = emptyRange, emptyRange
+	if r.Len() == 0 {
+		return before, after
+	}
+	if d > r.Start {
+		before = DateRange{Start: r.Start, End: d - 1, OpenStart: r.OpenStart}
+		if before.End >= r.End {
+			before.End, before.OpenEnd = r.End, r.OpenEnd
+		}
+	}
+	if d <= r.End {
+		after = DateRange{Start: d, End: r.End, OpenEnd: r.OpenEnd}
+		if after.Start <= r.Start {
+			after.Start, after.OpenStart = r.Start, r.OpenStart
+		}
+	}
+	return before, after
+}
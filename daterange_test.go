@@ -0,0 +1,222 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+func TestDateRange_Subtract(t *testing.T) {
+	r := DateRange{Start: 10, End: 20}
+	tests := []struct {
+		other DateRange
+		want  []DateRange
+	}{
+		{DateRange{Start: 0, End: 5}, []DateRange{{Start: 10, End: 20}}},
+		{DateRange{Start: 21, End: 30}, []DateRange{{Start: 10, End: 20}}},
+		{DateRange{Start: 15, End: 14}, []DateRange{{Start: 10, End: 20}}},
+		{DateRange{Start: 0, End: 12}, []DateRange{{Start: 13, End: 20}}},
+		{DateRange{Start: 18, End: 30}, []DateRange{{Start: 10, End: 17}}},
+		{DateRange{Start: 12, End: 15}, []DateRange{{Start: 10, End: 11}, {Start: 16, End: 20}}},
+		{DateRange{Start: 10, End: 20}, nil},
+		{DateRange{Start: 0, End: maxDate}, nil},
+	}
+	for _, tt := range tests {
+		if got := r.Subtract(tt.other); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v.Subtract(%v) = %v; want %v", r, tt.other, got, tt.want)
+		}
+	}
+	if got := (DateRange{Start: 5, End: 4}).Subtract(DateRange{Start: 0, End: 1}); got != nil {
+		t.Errorf("empty Subtract = %v; want nil", got)
+	}
+}
+
+func TestDateRange_SplitAt(t *testing.T) {
+	tests := []struct {
+		r             DateRange
+		d             Date
+		before, after DateRange
+	}{
+		{DateRange{Start: 10, End: 20}, 15, DateRange{Start: 10, End: 14}, DateRange{Start: 15, End: 20}},
+		{DateRange{Start: 10, End: 20}, 10, emptyRange, DateRange{Start: 10, End: 20}},
+		{DateRange{Start: 10, End: 20}, 5, emptyRange, DateRange{Start: 10, End: 20}},
+		{DateRange{Start: 10, End: 20}, 20, DateRange{Start: 10, End: 19}, DateRange{Start: 20, End: 20}},
+		{DateRange{Start: 10, End: 20}, 21, DateRange{Start: 10, End: 20}, emptyRange},
+		{DateRange{Start: 0, End: maxDate}, 0, emptyRange, DateRange{Start: 0, End: maxDate}},
+		{DateRange{Start: 0, End: maxDate}, maxDate, DateRange{Start: 0, End: maxDate - 1}, DateRange{Start: maxDate, End: maxDate}},
+		{DateRange{Start: 20, End: 10}, 15, emptyRange, emptyRange},
+	}
+	for _, tt := range tests {
+		before, after := tt.r.SplitAt(tt.d)
+		if before != tt.before || after != tt.after {
+			t.Errorf("%v.SplitAt(%d) = %v, %v; want %v, %v", tt.r, tt.d, before, after, tt.before, tt.after)
+		}
+		if n := before.Len() + after.Len(); n != tt.r.Len() {
+			t.Errorf("%v.SplitAt(%d) parts have %d days; want %d", tt.r, tt.d, n, tt.r.Len())
+		}
+	}
+}
+
+func TestDateRange_String(t *testing.T) {
+	start, end := MustParseRFC("2024-01-01"), MustParseRFC("2024-12-31")
+	tests := []struct {
+		r DateRange
+		s string
+	}{
+		{DateRange{Start: start, End: end}, "2024-01-01/2024-12-31"},
+		{RangeFrom(start), "2024-01-01/.."},
+		{RangeThrough(end), "../2024-12-31"},
+		{DateRange{End: maxDate, OpenStart: true, OpenEnd: true}, "../.."},
+		{DateRange{Start: 0, End: maxDate}, "1970-01-01/2149-06-06"},
+	}
+	for _, tt := range tests {
+		if s := tt.r.String(); s != tt.s {
+			t.Errorf("%#v.String() = %q; want %q", tt.r, s, tt.s)
+		}
+		r, err := ParseDateRange(tt.s)
+		if err != nil || r != tt.r {
+			t.Errorf("ParseDateRange(%q) = %#v, %v; want %#v", tt.s, r, err, tt.r)
+		}
+	}
+
+	for _, s := range []string{"", "2024-01-01", "2024-01-01/", "/..", "2024-01-01/2024-13-01", "2024-01-01..2024-02-01", "2024-12-31/2024-01-01"} {
+		if r, err := ParseDateRange(s); err == nil {
+			t.Errorf("ParseDateRange(%q) = %v; want error", s, r)
+		}
+	}
+
+	var v struct{ Term DateRange }
+	if err := json.Unmarshal([]byte(`{"Term":"2024-01-01/.."}`), &v); err != nil || v.Term != RangeFrom(start) {
+		t.Errorf("json.Unmarshal = %#v, %v", v.Term, err)
+	}
+	if b, err := json.Marshal(v); err != nil || string(b) != `{"Term":"2024-01-01/.."}` {
+		t.Errorf("json.Marshal = %s, %v", b, err)
+	}
+}
+
+func TestDateRange_open(t *testing.T) {
+	r := RangeFrom(100)
+	if r.Len() != maxDate-100+1 {
+		t.Errorf("RangeFrom(100) has %d days", r.Len())
+	}
+	parts := r.Subtract(DateRange{Start: 200, End: 300})
+	want := []DateRange{{Start: 100, End: 199}, {Start: 301, End: maxDate, OpenEnd: true}}
+	if !reflect.DeepEqual(parts, want) {
+		t.Errorf("Subtract = %v; want %v", parts, want)
+	}
+	before, after := RangeThrough(500).SplitAt(600)
+	if before != RangeThrough(500) || after != emptyRange {
+		t.Errorf("SplitAt beyond end = %v, %v", before, after)
+	}
+	before, after = RangeThrough(500).SplitAt(0)
+	if before != emptyRange || after != RangeThrough(500) {
+		t.Errorf("SplitAt start = %v, %v", before, after)
+	}
+}
+
+func TestDateRange_Contains(t *testing.T) {
+	r := DateRange{Start: 10, End: 20}
+	for d, want := range map[Date]bool{9: false, 10: true, 15: true, 20: true, 21: false} {
+		if got := r.Contains(d); got != want {
+			t.Errorf("%v.Contains(%d) = %v; want %v", r, d, got, want)
+		}
+	}
+	if emptyRange.Contains(0) || emptyRange.Contains(1) {
+		t.Error("empty range contains a date")
+	}
+	if n := (DateRange{Start: 0, End: maxDate}).Len(); n != maxDate+1 {
+		t.Errorf("Len of all dates = %d; want %d", n, maxDate+1)
+	}
+}
+
+func TestDateRange_IntersectUnion(t *testing.T) {
+	r := DateRange{Start: 10, End: 20}
+	tests := []struct {
+		other     DateRange
+		overlaps  bool
+		intersect DateRange
+		union     DateRange
+		unionOK   bool
+	}{
+		{DateRange{Start: 0, End: 5}, false, emptyRange, emptyRange, false},
+		{DateRange{Start: 0, End: 9}, false, emptyRange, DateRange{Start: 0, End: 20}, true},
+		{DateRange{Start: 21, End: 30}, false, emptyRange, DateRange{Start: 10, End: 30}, true},
+		{DateRange{Start: 15, End: 30}, true, DateRange{Start: 15, End: 20}, DateRange{Start: 10, End: 30}, true},
+		{DateRange{Start: 12, End: 14}, true, DateRange{Start: 12, End: 14}, r, true},
+		{emptyRange, false, emptyRange, r, true},
+		{RangeFrom(18), true, DateRange{Start: 18, End: 20}, DateRange{Start: 10, End: maxDate, OpenEnd: true}, true},
+		{RangeThrough(12), true, DateRange{Start: 10, End: 12}, DateRange{Start: 0, End: 20, OpenStart: true}, true},
+	}
+	for _, tt := range tests {
+		if got := r.Overlaps(tt.other); got != tt.overlaps {
+			t.Errorf("%v.Overlaps(%v) = %v; want %v", r, tt.other, got, tt.overlaps)
+		}
+		if got := tt.other.Overlaps(r); got != tt.overlaps {
+			t.Errorf("%v.Overlaps(%v) = %v; want %v", tt.other, r, got, tt.overlaps)
+		}
+		if got := r.Intersect(tt.other); got != tt.intersect {
+			t.Errorf("%v.Intersect(%v) = %+v; want %+v", r, tt.other, got, tt.intersect)
+		}
+		if got, ok := r.Union(tt.other); got != tt.union || ok != tt.unionOK {
+			t.Errorf("%v.Union(%v) = %+v, %v; want %+v, %v", r, tt.other, got, ok, tt.union, tt.unionOK)
+		}
+	}
+
+	open := RangeFrom(5).Intersect(RangeFrom(10))
+	if want := RangeFrom(10); open != want {
+		t.Errorf("RangeFrom(5).Intersect(RangeFrom(10)) = %+v; want %+v", open, want)
+	}
+}
+
+func TestDateRange_Iter(t *testing.T) {
+	var got []Date
+	it := DateRange{Start: maxDate - 2, End: maxDate}.Iter()
+	for d, ok := it.Next(); ok; d, ok = it.Next() {
+		got = append(got, d)
+	}
+	if want := []Date{maxDate - 2, maxDate - 1, maxDate}; !reflect.DeepEqual(got, want) {
+		t.Errorf("dates = %v; want %v", got, want)
+	}
+	if _, ok := emptyRange.Iter().Next(); ok {
+		t.Error("empty range yielded a date")
+	}
+}
+
+func TestDateRange_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       DateRange
+		wantErr bool
+	}{
+		{name: "zero", r: DateRange{}},
+		{name: "closed", r: DateRange{Start: MustParseRFC("2024-01-01"), End: MustParseRFC("2024-12-31")}},
+		{name: "from", r: RangeFrom(MustParseRFC("2024-01-01"))},
+		{name: "through", r: RangeThrough(MustParseRFC("2024-01-01"))},
+		{name: "unbounded", r: DateRange{End: maxDate, OpenStart: true, OpenEnd: true}},
+		{name: "reversed", r: DateRange{Start: MustParseRFC("2024-12-31"), End: MustParseRFC("2024-01-01")}, wantErr: true},
+		{name: "empty", r: emptyRange, wantErr: true},
+		{name: "open_start", r: DateRange{Start: 1, End: 2, OpenStart: true}, wantErr: true},
+		{name: "open_end", r: DateRange{Start: 1, End: 2, OpenEnd: true}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.r.Validate()
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("%v.Validate() = nil, want error", tt.r)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("%v.Validate() = %q, want nil", tt.r, err)
+			}
+		})
+	}
+
+	f := func(r DateRange) bool {
+		return r.Validate() == nil
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
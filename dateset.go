@@ -0,0 +1,39 @@
+package epochdate
+
+import "sort"
+
+// DateSet is an unordered set of dates. The zero value is an empty set which
+// may be queried but not modified; use make or NewDateSet to create a set
+// which can be modified.
+//
+type DateSet map[Date]struct{}
+
+// NewDateSet returns a set containing the given dates.
+func NewDateSet(dates ...Date) DateSet {
+	s := make(DateSet, len(dates))
+	for _, d := range dates {
+		s.Add(d)
+	}
+	return s
+}
+
+// Add adds d to the set.
+func (s DateSet) Add(d Date) {
+	s[d] = struct{}{}
+}
+
+// Contains reports whether d is in the set.
+func (s DateSet) Contains(d Date) bool {
+	_, ok := s[d]
+	return ok
+}
+
+// Sorted returns the dates in the set in ascending order.
+func (s DateSet) Sorted() []Date {
+	dates := make([]Date, 0, len(s))
+	for d := range s {
+		dates = append(dates, d)
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i] < dates[j] })
+	return dates
+}
@@ -0,0 +1,36 @@
+package epochdate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDateSet(t *testing.T) {
+	s := NewDateSet(3, 1, maxDate, 1)
+
+	if got, want := len(s), 3; got != want {
+		t.Errorf("len(NewDateSet(3, 1, max, 1)) = %d, want %d", got, want)
+	}
+
+	for _, d := range []Date{1, 3, maxDate} {
+		if !s.Contains(d) {
+			t.Errorf("%v.Contains(%d) = false, want true", s.Sorted(), d)
+		}
+	}
+
+	for _, d := range []Date{0, 2, maxDate - 1} {
+		if s.Contains(d) {
+			t.Errorf("%v.Contains(%d) = true, want false", s.Sorted(), d)
+		}
+	}
+
+	s.Add(2)
+	if got, want := s.Sorted(), []Date{1, 2, 3, maxDate}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DateSet.Sorted() = %v, want %v", got, want)
+	}
+
+	var empty DateSet
+	if empty.Contains(0) || len(empty.Sorted()) != 0 {
+		t.Errorf("zero DateSet should be empty")
+	}
+}
@@ -0,0 +1,98 @@
+package epochdate
+
+import "time"
+
+// DeadlineCalculator computes due dates from the instants at which requests
+// are received, as for service-level agreements, court filings, and
+// payments. A request received on a non-business day, or at or after the
+// cutoff time on a business day, is treated as received on the next
+// business day, from which the allowed days are counted:
+//
+//	calc := epochdate.DeadlineCalculator{
+//		Calendar: epochdate.HolidayCalendar{Weekend: epochdate.SaturdaySunday},
+//		Location: newYork,
+//		Cutoff:   17 * time.Hour,
+//		Days:     3,
+//	}
+//	due, err := calc.Due(receivedAt)
+//
+type DeadlineCalculator struct {
+	// Calendar determines business days. It must not be nil.
+	Calendar CalendarProvider
+
+	// Location is the time zone in which received instants are dated and
+	// compared with Cutoff. A nil Location is UTC.
+	Location *time.Location
+
+	// Cutoff is the wall-clock time of day, as a duration such as
+	// 17*time.Hour, from which requests count as received on the next
+	// business day. Zero means there is no cutoff.
+	Cutoff time.Duration
+
+	// Days is the number of days allowed after the effective date of
+	// receipt.
+	Days int
+
+	// CalendarDays counts Days as calendar days, rather than business days,
+	// after which Adjust moves the due date to a business day, as for
+	// court deadlines which fall on weekends.
+	CalendarDays bool
+
+	// Adjust applies when CalendarDays is set. AdjustNone leaves due dates
+	// on non-business days.
+	Adjust Adjustment
+}
+
+// ReceivedOn returns the effective date of receipt of a request received at
+// t: the date of t, or the next business day if the date of t is not a
+// business day, or t is at or after the cutoff. ErrOutOfRange is returned
+// if the date is not representable.
+//
+func (c DeadlineCalculator) ReceivedOn(t time.Time) (Date, error) {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	d, err := NewFromTime(t)
+	if err != nil {
+		return 0, err
+	}
+	if c.Cutoff > 0 {
+		h, m, s := t.Clock()
+		wall := time.Duration(h)*time.Hour + time.Duration(m)*time.Minute +
+			time.Duration(s)*time.Second + time.Duration(t.Nanosecond())
+		if wall >= c.Cutoff {
+			return NextBusinessDay(c.Calendar, d)
+		}
+	}
+	if !IsBusinessDay(c.Calendar, d) {
+		return NextBusinessDay(c.Calendar, d)
+	}
+	return d, nil
+}
+
+// Due returns the due date of a request received at t. ErrOutOfRange is
+// returned if the due date is not representable.
+//
+func (c DeadlineCalculator) Due(t time.Time) (Date, error) {
+	d, err := c.ReceivedOn(t)
+	if err != nil {
+		return 0, err
+	}
+	return c.DueFrom(d)
+}
+
+// DueFrom returns the due date of a request whose effective date of receipt
+// is d, as returned by ReceivedOn.
+//
+func (c DeadlineCalculator) DueFrom(d Date) (Date, error) {
+	if !c.CalendarDays {
+		return AddBusinessDays(c.Calendar, d, c.Days)
+	}
+	due := int(d) + c.Days
+	if due < 0 || due > maxDate {
+		return 0, ErrOutOfRange
+	}
+	return c.Adjust.Adjust(c.Calendar, Date(due))
+}
@@ -0,0 +1,63 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeadlineCalculator(t *testing.T) {
+	cal := HolidayCalendar{
+		Weekend:  SaturdaySunday,
+		Holidays: NewDateSet(MustParseRFC("2024-12-25"), MustParseRFC("2024-12-26")),
+	}
+	est := time.FixedZone("EST", -5*3600)
+	calc := DeadlineCalculator{Calendar: cal, Location: est, Cutoff: 17 * time.Hour, Days: 2}
+
+	tests := []struct {
+		received string
+		on, due  string
+	}{
+		{"2024-12-20T09:00:00-05:00", "2024-12-20", "2024-12-24"}, // Friday
+		{"2024-12-20T16:59:59-05:00", "2024-12-20", "2024-12-24"},
+		{"2024-12-20T17:00:00-05:00", "2024-12-23", "2024-12-27"}, // at the cutoff
+		{"2024-12-20T21:30:00Z", "2024-12-20", "2024-12-24"},      // 16:30 in EST
+		{"2024-12-21T10:00:00-05:00", "2024-12-23", "2024-12-27"}, // Saturday
+		{"2024-12-24T18:00:00-05:00", "2024-12-27", "2024-12-31"}, // before holidays
+		{"2024-12-25T08:00:00-05:00", "2024-12-27", "2024-12-31"}, // holiday
+	}
+	for _, test := range tests {
+		received, err := time.Parse(time.RFC3339, test.received)
+		if err != nil {
+			t.Fatal(err)
+		}
+		on, err := calc.ReceivedOn(received)
+		if err != nil || on.String() != test.on {
+			t.Errorf("ReceivedOn(%s) = %v, %v; want %s", test.received, on, err, test.on)
+		}
+		due, err := calc.Due(received)
+		if err != nil || due.String() != test.due {
+			t.Errorf("Due(%s) = %v, %v; want %s", test.received, due, err, test.due)
+		}
+	}
+}
+
+func TestDeadlineCalculator_calendarDays(t *testing.T) {
+	cal := HolidayCalendar{Weekend: SaturdaySunday}
+	calc := DeadlineCalculator{Calendar: cal, Days: 30, CalendarDays: true}
+	start := MustParseRFC("2024-05-02") // 30 days later is a Saturday
+
+	due, err := calc.DueFrom(start)
+	if err != nil || due.String() != "2024-06-01" {
+		t.Errorf("DueFrom without adjustment = %v, %v; want 2024-06-01", due, err)
+	}
+	calc.Adjust = AdjustFollowing
+	due, err = calc.DueFrom(start)
+	if err != nil || due.String() != "2024-06-03" {
+		t.Errorf("DueFrom following = %v, %v; want 2024-06-03", due, err)
+	}
+
+	calc.Days = 1
+	if _, err := calc.DueFrom(maxDate); err != ErrOutOfRange {
+		t.Errorf("DueFrom(max) error = %v; want ErrOutOfRange", err)
+	}
+}
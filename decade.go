@@ -0,0 +1,40 @@
+package epochdate
+
+import "time"
+
+// StartOfDecade returns the first day of the decade containing d, such as
+// 2020-01-01 for any date in the years 2020 through 2029.
+//
+func (d Date) StartOfDecade() Date {
+	year := d.UTC().Year()
+	return ClampFromDate(year-year%10, time.January, 1)
+}
+
+// EndOfDecade returns the last day of the decade containing d, such as
+// 2029-12-31 for any date in the years 2020 through 2029. The result is
+// clamped to 2149-06-06 during the 2140s, whose end is not representable.
+//
+func (d Date) EndOfDecade() Date {
+	year := d.UTC().Year()
+	return ClampFromDate(year-year%10+9, time.December, 31)
+}
+
+// StartOfCentury returns the first day of the century containing d, where
+// centuries are the years sharing their leading digits, such as 2000 through
+// 2099, rather than the strict reckoning from 2001 through 2100. The result
+// is clamped to 1970-01-01 during the 1900s, whose start is not
+// representable.
+//
+func (d Date) StartOfCentury() Date {
+	year := d.UTC().Year()
+	return ClampFromDate(year-year%100, time.January, 1)
+}
+
+// EndOfCentury returns the last day of the century containing d, as defined
+// by StartOfCentury. The result is clamped to 2149-06-06 during the 2100s,
+// whose end is not representable.
+//
+func (d Date) EndOfCentury() Date {
+	year := d.UTC().Year()
+	return ClampFromDate(year-year%100+99, time.December, 31)
+}
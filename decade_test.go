@@ -0,0 +1,34 @@
+package epochdate
+
+import "testing"
+
+func TestDecadeCentury(t *testing.T) {
+	tests := []struct {
+		date                   string
+		decadeStart, decadeEnd string
+		centStart, centEnd     string
+	}{
+		{"1970-01-01", "1970-01-01", "1979-12-31", "1970-01-01", "1999-12-31"},
+		{"1999-12-31", "1990-01-01", "1999-12-31", "1970-01-01", "1999-12-31"},
+		{"2000-01-01", "2000-01-01", "2009-12-31", "2000-01-01", "2099-12-31"},
+		{"2024-06-15", "2020-01-01", "2029-12-31", "2000-01-01", "2099-12-31"},
+		{"2100-03-01", "2100-01-01", "2109-12-31", "2100-01-01", "2149-06-06"},
+		{"2149-06-06", "2140-01-01", "2149-06-06", "2100-01-01", "2149-06-06"},
+	}
+	for _, tt := range tests {
+		d := MustParseRFC(tt.date)
+		for _, c := range []struct {
+			name      string
+			got, want string
+		}{
+			{"StartOfDecade", d.StartOfDecade().String(), tt.decadeStart},
+			{"EndOfDecade", d.EndOfDecade().String(), tt.decadeEnd},
+			{"StartOfCentury", d.StartOfCentury().String(), tt.centStart},
+			{"EndOfCentury", d.EndOfCentury().String(), tt.centEnd},
+		} {
+			if c.got != c.want {
+				t.Errorf("%s.%s() = %s; want %s", tt.date, c.name, c.got, c.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+//go:build go1.23
+// +build go1.23
+
+package epochdate
+
+import "iter"
+
+// AllDates returns an iterator over every representable Date, from
+// 1970-01-01 through 2149-06-06, in order. Since there are only 65536
+// dates, it is practical to verify properties of functions of dates
+// exhaustively, or to precompute tables indexed by Date:
+//
+//	for d := range epochdate.AllDates() {
+//		if got := f(d); got != want(d) {
+//			t.Errorf("f(%v) = %v", d, got)
+//		}
+//	}
+//
+func AllDates() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		for n := 0; n <= maxDate; n++ {
+			if !yield(Date(n)) {
+				return
+			}
+		}
+	}
+}
+
+// AllYearMonths returns an iterator over every representable YearMonth, from
+// 1970-01 through 7431-04, in order. Only those through 2149-06 are
+// compatible with Date; see YearMonth.Validate.
+//
+func AllYearMonths() iter.Seq[YearMonth] {
+	return func(yield func(YearMonth) bool) {
+		for n := 0; n <= maxDate; n++ {
+			if !yield(YearMonth(n)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,47 @@
+//go:build go1.23
+// +build go1.23
+
+package epochdate
+
+import "testing"
+
+func TestAllDates(t *testing.T) {
+	n := 0
+	for d := range AllDates() {
+		if int(d) != n {
+			t.Fatalf("date %d = %v; want day %d", n, d, n)
+		}
+		if got, err := ParseRFC(d.String()); err != nil || got != d {
+			t.Fatalf("ParseRFC(%v) = %v, %v", d, got, err)
+		}
+		n++
+	}
+	if n != maxDate+1 {
+		t.Errorf("AllDates yielded %d dates; want %d", n, maxDate+1)
+	}
+
+	n = 0
+	for range AllDates() {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("AllDates yielded %d dates after break; want 3", n)
+	}
+}
+
+func TestAllYearMonths(t *testing.T) {
+	var first, last YearMonth
+	n := 0
+	for ym := range AllYearMonths() {
+		if n == 0 {
+			first = ym
+		}
+		last = ym
+		n++
+	}
+	if n != maxDate+1 || first.String() != "1970-01" || last.String() != "7431-04" {
+		t.Errorf("AllYearMonths yielded %d months, %v through %v", n, first, last)
+	}
+}
@@ -0,0 +1,49 @@
+package epochdate
+
+import (
+	"errors"
+	"time"
+)
+
+// DOS dates, as used by FAT filesystems and ZIP archives, pack a date into
+// 16 bits: the year since 1980 in the high 7 bits, then the month (1-12) in
+// 4 bits, then the day of the month (1-31) in the low 5 bits, so that they
+// can represent 1980-01-01 through 2107-12-31.
+//
+const dosMinYear = 1980
+
+var (
+	errDOSDate      = errors.New("epochdate: invalid DOS date")
+	errDOSDateRange = errors.New("epochdate: DOS dates must be in the range [1980-01-01,2107-12-31]")
+)
+
+// NewFromDOSDate returns the Date represented by a packed DOS date, such as
+// the ModifiedDate field of an archive/zip FileHeader. It returns an error
+// if the month or day is invalid, as is the case for the zero value, which
+// some tools write for unknown dates.
+//
+func NewFromDOSDate(v uint16) (Date, error) {
+	year := dosMinYear + int(v>>9)
+	month := time.Month(v >> 5 & 0xf)
+	day := int(v & 0x1f)
+	if month < time.January || month > time.December || day < 1 || day > daysIn(year, month) {
+		return 0, errDOSDate
+	}
+	return NewFromDate(year, month, day)
+}
+
+// DOSDate returns d as a packed DOS date, or an error if it is outside the
+// range representable by DOS dates.
+//
+func (d Date) DOSDate() (uint16, error) {
+	year, month, day := d.Date()
+	if year < dosMinYear || year > dosMinYear+0x7f {
+		return 0, errDOSDateRange
+	}
+	return uint16(year-dosMinYear)<<9 | uint16(month)<<5 | uint16(day), nil
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,59 @@
+package epochdate
+
+import (
+	"archive/zip"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+func TestDOSDate(t *testing.T) {
+	min, max := MustParseRFC("1980-01-01"), MustParseRFC("2107-12-31")
+
+	f := func(d Date) bool {
+		v, err := d.DOSDate()
+		if d < min || d > max {
+			return err != nil
+		}
+		d2, err2 := NewFromDOSDate(v)
+		return err == nil && err2 == nil && d2 == d
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+
+	// Compare with the encoding used by archive/zip.
+	for _, d := range []Date{min, MustParseRFC("2024-02-29"), max} {
+		var h zip.FileHeader
+		h.SetModTime(d.UTC())
+		if v, err := d.DOSDate(); err != nil || v != h.ModifiedDate {
+			t.Errorf("%v.DOSDate() = %#x, %v; want %#x", d, v, err, h.ModifiedDate)
+		}
+	}
+	for _, d := range []Date{min - 1, max + 1} {
+		if v, err := d.DOSDate(); err == nil {
+			t.Errorf("%v.DOSDate() = %#x, want error", d, v)
+		}
+	}
+}
+
+func TestNewFromDOSDate(t *testing.T) {
+	pack := func(y int, m time.Month, d int) uint16 {
+		return uint16(y-1980)<<9 | uint16(m)<<5 | uint16(d)
+	}
+	for _, v := range []uint16{
+		0,
+		pack(2024, 0, 1),
+		pack(2024, 13, 1),
+		pack(2024, 1, 0),
+		pack(2023, 2, 29),
+		pack(2024, 4, 31),
+	} {
+		if d, err := NewFromDOSDate(v); err == nil {
+			t.Errorf("NewFromDOSDate(%#x) = %v, want error", v, d)
+		}
+	}
+	if d, err := NewFromDOSDate(pack(2024, 2, 29)); err != nil || d != MustParseRFC("2024-02-29") {
+		t.Errorf("NewFromDOSDate(2024-02-29) = %v, %v", d, err)
+	}
+}
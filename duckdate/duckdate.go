@@ -0,0 +1,94 @@
+// Package duckdate binds Dates to DuckDB DATE columns, which hold 32-bit
+// day counts since 1970-01-01, through github.com/marcboeker/go-duckdb or
+// any driver with the same conventions. It depends only on the standard
+// library, so that programs need not link DuckDB to compile against it.
+//
+//	var d epochdate.Date
+//	err := db.QueryRow("SELECT max(settled) FROM trades").Scan(duckdate.Scan(&d))
+//
+//	appender, err := duckdb.NewAppenderFromConn(conn, "", "holidays")
+//	err = duckdate.AppendDates(appender, dates)
+//
+package duckdate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+var errNull = errors.New("duckdate: cannot scan NULL into Date")
+
+// Appender is the subset of the go-duckdb Appender used by AppendDates.
+type Appender interface {
+	AppendRow(args ...driver.Value) error
+}
+
+// Value returns d as a value accepted by DuckDB for DATE columns, both as a
+// query argument and by Appender.AppendRow.
+//
+func Value(d epochdate.Date) driver.Value {
+	return d.UTC()
+}
+
+// AppendDates appends a row to a single-column table for each date.
+func AppendDates(a Appender, dates []epochdate.Date) error {
+	for _, d := range dates {
+		if err := a.AppendRow(Value(d)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Scan returns a sql.Scanner which stores a DATE into d. It accepts the
+// time.Time values which go-duckdb returns for DATE columns, as well as the
+// raw day counts returned by casting a DATE to an integer, such as
+// epoch_days or date_diff results.
+//
+func Scan(d *epochdate.Date) sql.Scanner {
+	return scanner{d}
+}
+
+type scanner struct {
+	d *epochdate.Date
+}
+
+func (s scanner) Scan(src interface{}) error {
+	var (
+		v   epochdate.Date
+		err error
+	)
+	switch x := src.(type) {
+	case time.Time:
+		v, err = epochdate.NewFromTime(x)
+
+	case int32:
+		v, err = epochdate.NewFromInt32(x)
+
+	case int64:
+		v, err = fromDays(x)
+
+	case nil:
+		return errNull
+
+	default:
+		return fmt.Errorf("duckdate: cannot scan %T into Date", src)
+	}
+	if err != nil {
+		return err
+	}
+	*s.d = v
+	return nil
+}
+
+func fromDays(days int64) (epochdate.Date, error) {
+	if days < -1<<31 || days >= 1<<31 {
+		return 0, epochdate.ErrOutOfRange
+	}
+	return epochdate.NewFromInt32(int32(days))
+}
@@ -0,0 +1,54 @@
+package duckdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+type recorder [][]driver.Value
+
+func (r *recorder) AppendRow(args ...driver.Value) error {
+	if len(*r) == 2 {
+		return errors.New("full")
+	}
+	*r = append(*r, args)
+	return nil
+}
+
+func TestAppendDates(t *testing.T) {
+	d := epochdate.MustParseRFC("2024-03-01")
+	var r recorder
+	if err := AppendDates(&r, []epochdate.Date{d, d + 1}); err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 2 || r[0][0] != d.UTC() || r[1][0] != (d+1).UTC() {
+		t.Errorf("appended rows = %v", r)
+	}
+	if err := AppendDates(&r, []epochdate.Date{d}); err == nil {
+		t.Error("AppendDates did not return the appender's error")
+	}
+}
+
+func TestScan(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	for _, src := range []interface{}{
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		int32(19783),
+		int64(19783),
+	} {
+		var d epochdate.Date
+		if err := Scan(&d).Scan(src); err != nil || d != want {
+			t.Errorf("Scan(%#v) = %v, %v; want %v", src, d, err, want)
+		}
+	}
+	for _, src := range []interface{}{nil, "2024-03-01", int32(-1), int64(1 << 40)} {
+		d := want
+		if err := Scan(&d).Scan(src); err == nil || d != want {
+			t.Errorf("Scan(%#v) = %v, %v; want error", src, d, err)
+		}
+	}
+}
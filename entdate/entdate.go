@@ -0,0 +1,130 @@
+// Package entdate provides epochdate types for use in ent schemas. Date
+// and YearMonth implement field.ValueScanner, and are stored in DATE
+// columns; a YearMonth is stored as the first day of its month, so that
+// columns of either type may be compared and indexed by the database:
+//
+//	func (Invoice) Fields() []ent.Field {
+//		return []ent.Field{
+//			entdate.DateField("issued"),
+//			entdate.YearMonthField("period"),
+//		}
+//	}
+//
+// Fields needing other options, such as Optional or Nillable, may be
+// declared using field.Other directly:
+//
+//	field.Other("settled", entdate.Date{}).SchemaType(entdate.SchemaType).Optional()
+//
+package entdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"github.com/xtgo/epochdate"
+)
+
+var errNull = errors.New("entdate: cannot scan NULL; declare the field Nillable")
+
+// SchemaType maps each dialect supported by ent to the column type used for
+// Date and YearMonth fields.
+//
+var SchemaType = map[string]string{
+	dialect.MySQL:    "date",
+	dialect.Postgres: "date",
+	dialect.SQLite:   "date",
+}
+
+// DateField returns a field holding a Date.
+func DateField(name string) ent.Field {
+	return field.Other(name, Date{}).SchemaType(SchemaType)
+}
+
+// YearMonthField returns a field holding a YearMonth.
+func YearMonthField(name string) ent.Field {
+	return field.Other(name, YearMonth{}).SchemaType(SchemaType)
+}
+
+// Date is an epochdate.Date which may be stored in an ent field.
+type Date struct {
+	epochdate.Date
+}
+
+// Scan implements sql.Scanner.
+func (d *Date) Scan(src interface{}) error {
+	v, err := scan(src)
+	if err != nil {
+		return err
+	}
+	d.Date = v
+	return nil
+}
+
+// Value implements driver.Valuer, returning midnight UTC on the date.
+func (d Date) Value() (driver.Value, error) {
+	return d.UTC(), nil
+}
+
+// YearMonth is an epochdate.YearMonth which may be stored in an ent field.
+type YearMonth struct {
+	epochdate.YearMonth
+}
+
+// Scan implements sql.Scanner. Any day of the month is accepted.
+func (ym *YearMonth) Scan(src interface{}) error {
+	v, err := scan(src)
+	if err != nil {
+		return err
+	}
+	ym.YearMonth = v.YearMonth()
+	return nil
+}
+
+// Value implements driver.Valuer, returning midnight UTC on the first day of
+// the month. It returns an error if ym is beyond the range of Date.
+//
+func (ym YearMonth) Value() (driver.Value, error) {
+	if err := ym.Validate(); err != nil {
+		return nil, err
+	}
+	return ym.StartDate().UTC(), nil
+}
+
+// scan converts a value returned by a driver to a Date. Times are converted
+// using the date in their own location, since drivers return DATE columns
+// as midnight in either UTC or the connection's location.
+//
+func scan(src interface{}) (epochdate.Date, error) {
+	switch v := src.(type) {
+	case nil:
+		return 0, errNull
+
+	case time.Time:
+		return epochdate.NewFromTime(v)
+
+	case string:
+		return parse(v)
+
+	case []byte:
+		return parse(string(v))
+	}
+	return 0, fmt.Errorf("entdate: cannot scan %T into a date", src)
+}
+
+// parse parses a DATE column in text form, which some drivers return with a
+// time component.
+//
+func parse(s string) (epochdate.Date, error) {
+	if len(s) > len(epochdate.RFC3339) {
+		switch s[len(epochdate.RFC3339)] {
+		case 'T', ' ':
+			s = s[:len(epochdate.RFC3339)]
+		}
+	}
+	return epochdate.ParseRFC(s)
+}
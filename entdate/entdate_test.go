@@ -0,0 +1,72 @@
+package entdate
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/schema/field"
+	"github.com/xtgo/epochdate"
+)
+
+func TestFields(t *testing.T) {
+	for _, f := range []interface{ Descriptor() *field.Descriptor }{
+		DateField("issued"),
+		YearMonthField("period"),
+	} {
+		desc := f.Descriptor()
+		if desc.Err != nil {
+			t.Errorf("%s: %v", desc.Name, desc.Err)
+		}
+		if desc.Info.Type != field.TypeOther || !desc.Info.ValueScanner() {
+			t.Errorf("%s: Info = %+v, want ValueScanner of TypeOther", desc.Name, desc.Info)
+		}
+		if desc.SchemaType["postgres"] != "date" {
+			t.Errorf("%s: SchemaType = %v, want date", desc.Name, desc.SchemaType)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	jst := time.FixedZone("JST", 9*60*60)
+	for _, src := range []interface{}{
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 0, 0, 0, 0, jst),
+		"2024-03-01",
+		[]byte("2024-03-01 00:00:00"),
+	} {
+		var d Date
+		if err := d.Scan(src); err != nil || d.Date != want {
+			t.Errorf("Scan(%v) = %v, %v; want %v", src, d, err, want)
+		}
+	}
+	for _, src := range []interface{}{nil, 19783, "March 1"} {
+		var d Date
+		if err := d.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) succeeded, want error", src)
+		}
+	}
+
+	v, err := Date{want}.Value()
+	if err != nil || v != time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+}
+
+func TestYearMonth(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01").YearMonth()
+	var ym YearMonth
+	if err := ym.Scan("2024-03-15"); err != nil || ym.YearMonth != want {
+		t.Errorf("Scan = %v, %v; want %v", ym, err, want)
+	}
+
+	v, err := YearMonth{want}.Value()
+	if err != nil || v != time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+
+	max := epochdate.Date(1<<16 - 1).YearMonth()
+	if _, err := (YearMonth{max + 1}).Value(); err == nil {
+		t.Errorf("Value() of %v succeeded, want error", max+1)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/xtgo/epochdate/entdate
+
+go 1.24
+
+require (
+	entgo.io/ent v0.14.6
+	github.com/xtgo/epochdate v0.0.0
+)
+
+require github.com/google/uuid v1.3.0 // indirect
+
+replace github.com/xtgo/epochdate => ../
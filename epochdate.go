@@ -55,6 +55,11 @@ import (
 // Consider using the ClampFrom* functions instead of NewFrom* when clamping
 // behavior is desired, as the ClampFrom* variants do not depend on the
 // value of this variable.
+//
+// Deprecated: Clamp affects every caller in the program, and must not be
+// modified while other goroutines may be parsing or converting dates. Use a
+// Parser, whose Clamp field affects only its own methods, or the ClampFrom*
+// functions.
 var Clamp = false
 
 const (
@@ -76,19 +81,49 @@ const (
 // ErrOutOfRange is returned if the input date is not a representable Date.
 var ErrOutOfRange = errors.New("epochdate: dates must be in the range [1970-01-01,2149-06-06]")
 
-// Today returns the local date at this instant. If the local date does not
-// fall within the representable range, then then zero value will be returned
+// Today returns the local date at this instant, according to DefaultClock.
+// If the local date does not fall within the representable range, then it
+// is clamped if Clamp is set, and otherwise the zero value will be returned
 // (1970-01-01).
+//
 func Today() Date {
-	date, _ := NewFromTime(time.Now())
+	date, _ := fromTime(DefaultClock.Now().Local(), Clamp)
 	return date
 }
 
-// TodayUTC returns the date at this instant, relative to UTC. If the UTC
-// date does not fall within the representable range, then then zero value
+// TodayErr is like Today, except that it returns ErrOutOfRange if the local
+// date does not fall within the representable range, such as when the system
+// clock has not been set. The error is reported regardless of Clamp.
+//
+func TodayErr() (Date, error) {
+	return fromTime(DefaultClock.Now().Local(), false)
+}
+
+// TodayUTC returns the date at this instant according to DefaultClock,
+// relative to UTC. If the UTC date does not fall within the representable
+// range, then it is clamped if Clamp is set, and otherwise the zero value
 // will be returned (1970-01-01).
+//
 func TodayUTC() Date {
-	date, _ := NewFromTime(time.Now().UTC())
+	date, _ := fromTime(DefaultClock.Now().UTC(), Clamp)
+	return date
+}
+
+// TodayUTCErr is like TodayUTC, except that it returns ErrOutOfRange if the
+// UTC date does not fall within the representable range, regardless of
+// Clamp.
+//
+func TodayUTCErr() (Date, error) {
+	return fromTime(DefaultClock.Now().UTC(), false)
+}
+
+// TodayIn returns the date at this instant according to DefaultClock,
+// relative to loc. If that date does not fall within the representable
+// range, then the zero value will be returned (1970-01-01). Unlike Today, the
+// result does not depend on the process-local time.Local setting.
+//
+func TodayIn(loc *time.Location) Date {
+	date, _ := NewFromTime(DefaultClock.Now().In(loc))
 	return date
 }
 
@@ -102,8 +137,14 @@ func Parse(layout, value string) (Date, error) {
 	return NewFromTime(t)
 }
 
-// ParseRFC is like Parse, except that the layout is fixed to RFC3339.
+// ParseRFC is like Parse, except that the layout is fixed to RFC3339. It
+// parses valid dates without time.Parse, which it uses only to report
+// errors, so that they are the same.
+//
 func ParseRFC(value string) (Date, error) {
+	if days, ok := parseRFC(value); ok {
+		return NewFromUnix(int64(days) * day)
+	}
 	t, err := time.Parse(RFC3339, value)
 	if err != nil {
 		return 0, err
@@ -145,9 +186,21 @@ func ClampFromTime(t time.Time) Date {
 // where t is a time.Time object.
 //
 func NewFromTime(t time.Time) (Date, error) {
-	s := t.Unix()
+	return fromTime(t, Clamp)
+}
+
+// fromTime is NewFromTime, clamping out-of-range dates if clamp is true.
+func fromTime(t time.Time, clamp bool) (Date, error) {
 	_, offset := t.Zone()
-	return NewFromUnix(s + int64(offset))
+	return newFromUnix(t.Unix()+int64(offset), clamp)
+}
+
+// NewFromTimeIn returns the Date on which the instant t falls in loc,
+// regardless of the location associated with t. It is equivalent to
+// NewFromTime(t.In(loc)).
+//
+func NewFromTimeIn(t time.Time, loc *time.Location) (Date, error) {
+	return NewFromTime(t.In(loc))
 }
 
 // ClampFromDate behaves like NewFromDate, except that it clamps
@@ -199,14 +252,19 @@ func ClampFromUnix(seconds int64) Date {
 // function.
 //
 func NewFromUnix(seconds int64) (Date, error) {
+	return newFromUnix(seconds, Clamp)
+}
+
+// newFromUnix is NewFromUnix, clamping out-of-range dates if clamp is true.
+func newFromUnix(seconds int64, clamp bool) (Date, error) {
 	switch {
 	case UnixInRange(seconds):
 		return Date(seconds / day), nil
 
-	case Clamp && seconds < 0:
+	case clamp && seconds < 0:
 		return 0, nil
 
-	case Clamp && seconds > maxUnix:
+	case clamp && seconds > maxUnix:
 		return maxDate, nil
 	}
 
@@ -228,7 +286,8 @@ type Date uint16
 
 // Returns an RFC3339/ISO-8601 date string, of the form "2006-01-02".
 func (d Date) String() string {
-	return d.Format(RFC3339)
+	var buf [len(RFC3339)]byte
+	return string(appendRFC(buf[:0], d))
 }
 
 // Unix returns the number of seconds elapsed since Jan 1 1970 UTC, from the
@@ -246,6 +305,23 @@ func (d Date) UnixNano() int64 {
 	return int64(d) * day * nsPerSec
 }
 
+// UnixRange returns the Unix timestamps bounding the receiver's UTC day, such
+// that the day includes start and every second up to, but excluding, end.
+// The result is suitable for range predicates such as
+// "ts >= start AND ts < end".
+//
+func (d Date) UnixRange() (start, end int64) {
+	start = d.Unix()
+	return start, start + day
+}
+
+// DaysUntil returns the number of days from the receiver until other. The
+// result is negative if other is before the receiver.
+//
+func (d Date) DaysUntil(other Date) int {
+	return int(other) - int(d)
+}
+
 // YearMonth returns the YearMonth that corresponds to the receiver.
 func (d Date) YearMonth() YearMonth {
 	y, m, _ := d.Date()
@@ -273,6 +349,9 @@ func (d Date) IsMax() bool {
 // specifiers that are used will be equivalent to "00:00:00Z".
 //
 func (d Date) Format(layout string) string {
+	if layout == RFC3339 {
+		return d.String()
+	}
 	return d.UTC().Format(layout)
 }
 
@@ -280,7 +359,7 @@ func (d Date) Format(layout string) string {
 // time.Time value.
 //
 func (d Date) Date() (year int, month time.Month, day int) {
-	return d.UTC().Date()
+	return civil(int(d))
 }
 
 // UTC returns a UTC Time object set to 00:00:00 on the given date.
@@ -293,16 +372,18 @@ func (d Date) Local() time.Time {
 	return d.In(time.Local)
 }
 
-// In returns a location-relative Time object set to 00:00:00 on the given date.
+// In returns a location-relative Time object set to 00:00:00 on the given
+// date. If midnight does not occur on that date in loc, the first instant of
+// the date is returned instead; see MidnightIn.
+//
 func (d Date) In(loc *time.Location) time.Time {
-	t := time.Unix(int64(d)*day, 0).In(loc)
-	_, offset := t.Zone()
-	return t.Add(time.Duration(-offset) * time.Second)
+	t, _ := d.MidnightIn(loc)
+	return t
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (d Date) MarshalText() ([]byte, error) {
-	return []byte(d.Format(RFC3339)), nil
+	return appendRFC(make([]byte, 0, len(RFC3339)), d), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -317,7 +398,9 @@ func (d *Date) UnmarshalText(data []byte) error {
 
 // MarshalJSON implements json.Marshaler.
 func (d Date) MarshalJSON() ([]byte, error) {
-	return []byte(d.Format(`"` + RFC3339 + `"`)), nil
+	b := make([]byte, 0, len(RFC3339)+2)
+	b = append(appendRFC(append(b, '"'), d), '"')
+	return b, nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
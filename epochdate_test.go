@@ -202,6 +202,30 @@ func TestTodayUTC(t *testing.T) {
 	}
 }
 
+func TestTodayIn(t *testing.T) {
+	locs := []*time.Location{
+		time.UTC,
+		time.FixedZone("UTC-12", -12*60*60),
+		time.FixedZone("UTC+14", +14*60*60),
+	}
+
+	for _, loc := range locs {
+		t.Run(loc.String(), func(t *testing.T) {
+			now := time.Now().In(loc)
+			if isLastMinuteOfDay(now) {
+				t.Skip("skipping time-sensitive test near end of day")
+			}
+
+			got := TodayIn(loc)
+			want := ClampFromDate(now.Date())
+
+			if got != want {
+				t.Errorf("TodayIn(%q) = %q, want %q", loc, got, want)
+			}
+		})
+	}
+}
+
 func TestDate_String(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -311,6 +335,60 @@ func TestDate_timezone_irrelevance(t *testing.T) {
 	}
 }
 
+func TestNewFromTimeIn(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	honolulu := time.FixedZone("HST", -10*60*60)
+
+	tests := []struct {
+		name    string
+		input   time.Time
+		loc     *time.Location
+		want    Date
+		wantErr bool
+	}{
+		{
+			name:  "same_zone",
+			input: time.Date(2020, 3, 1, 12, 0, 0, 0, tokyo),
+			loc:   tokyo,
+			want:  ClampFromDate(2020, 3, 1),
+		},
+		{
+			name:  "next_day_east",
+			input: time.Date(2020, 3, 1, 20, 0, 0, 0, time.UTC),
+			loc:   tokyo,
+			want:  ClampFromDate(2020, 3, 2),
+		},
+		{
+			name:  "previous_day_west",
+			input: time.Date(2020, 3, 1, 8, 0, 0, 0, tokyo),
+			loc:   honolulu,
+			want:  ClampFromDate(2020, 2, 29),
+		},
+		{
+			name:    "underflow",
+			input:   time.Date(1970, 1, 1, 5, 0, 0, 0, time.UTC),
+			loc:     honolulu,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewFromTimeIn(tt.input, tt.loc)
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("NewFromTimeIn(%q, %q) = nil [err], want error", tt.input, tt.loc)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("NewFromTimeIn(%q, %q) = %q [err], want nil", tt.input, tt.loc, err)
+
+			case got != tt.want:
+				t.Errorf("NewFromTimeIn(%q, %q) = %q, want %q", tt.input, tt.loc, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDate_UTC(t *testing.T) {
 	var date Date
 	local := date.Local()
@@ -337,6 +415,84 @@ func TestDate_Unix(t *testing.T) {
 	}
 }
 
+func TestDate_DaysUntil(t *testing.T) {
+	tests := []struct {
+		name  string
+		from  Date
+		until Date
+		want  int
+	}{
+		{
+			name:  "same",
+			from:  100,
+			until: 100,
+			want:  0,
+		},
+		{
+			name:  "forward",
+			from:  0,
+			until: maxDate,
+			want:  65535,
+		},
+		{
+			name:  "backward",
+			from:  maxDate,
+			until: 0,
+			want:  -65535,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.from.DaysUntil(tt.until)
+			if got != tt.want {
+				t.Errorf("%q.DaysUntil(%q) = %d, want %d", tt.from, tt.until, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_UnixRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Date
+		start int64
+		end   int64
+	}{
+		{
+			name:  "zero",
+			input: 0,
+			start: 0,
+			end:   day,
+		},
+		{
+			name:  "one",
+			input: 1,
+			start: day,
+			end:   2 * day,
+		},
+		{
+			name:  "max",
+			input: maxDate,
+			start: maxUnix - day + 1,
+			end:   maxUnix + 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.input.UnixRange()
+			if start != tt.start || end != tt.end {
+				t.Errorf("%q.UnixRange() = %d, %d, want %d, %d", tt.input, start, end, tt.start, tt.end)
+			}
+
+			if got := ClampFromUnix(end - 1); got != tt.input {
+				t.Errorf("ClampFromUnix(%d) = %q, want %q", end-1, got, tt.input)
+			}
+		})
+	}
+}
+
 func TestDate_MarshalText(t *testing.T) {
 	const (
 		unquoted = "1970-01-02"
@@ -0,0 +1,170 @@
+// Package epochdatetest provides utilities for testing code that uses the
+// epochdate package: a controllable Clock, test-failing constructors, and
+// assertions over dates, date sequences, and date sets.
+//
+package epochdatetest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// Clock is an epochdate.Clock whose current time only changes when
+// explicitly set or advanced. It is safe for concurrent use.
+//
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a Clock set to now.
+func NewClock(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// NewClockAt returns a Clock set to noon on the given date in loc.
+func NewClockAt(d epochdate.Date, loc *time.Location) *Clock {
+	return NewClock(d.Noon(loc))
+}
+
+// Now returns the clock's current time.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set sets the clock's current time to now.
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	c.now = now
+	c.mu.Unlock()
+}
+
+// Advance moves the clock's current time forward by d, which may be
+// negative.
+//
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	c.now = c.now.Add(d)
+	c.mu.Unlock()
+}
+
+// AdvanceDays moves the clock's current time forward by n calendar days in
+// its location, retaining the time of day, as with time.Time.AddDate.
+//
+func (c *Clock) AdvanceDays(n int) {
+	c.mu.Lock()
+	c.now = c.now.AddDate(0, 0, n)
+	c.mu.Unlock()
+}
+
+// MustDate parses s, which must be an RFC 3339 date such as "2024-03-01",
+// failing the test immediately if it cannot be parsed.
+//
+func MustDate(t testing.TB, s string) epochdate.Date {
+	t.Helper()
+	d, err := epochdate.ParseRFC(s)
+	if err != nil {
+		t.Fatalf("epochdatetest.MustDate(%q): %v", s, err)
+	}
+	return d
+}
+
+// MustDates is like MustDate, but parses each of the given strings.
+func MustDates(t testing.TB, ss ...string) []epochdate.Date {
+	t.Helper()
+	dates := make([]epochdate.Date, len(ss))
+	for i, s := range ss {
+		dates[i] = MustDate(t, s)
+	}
+	return dates
+}
+
+// FormatDates returns dates formatted one per line, each followed by a
+// newline, as is convenient for golden files and readable diffs.
+//
+func FormatDates(dates []epochdate.Date) string {
+	var b strings.Builder
+	for _, d := range dates {
+		b.WriteString(d.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// AssertDates reports an error unless got and want hold the same dates in
+// the same order.
+//
+func AssertDates(t testing.TB, got, want []epochdate.Date) bool {
+	t.Helper()
+	n := len(got)
+	if len(want) > n {
+		n = len(want)
+	}
+	ok := len(got) == len(want)
+	var diff strings.Builder
+	for i := 0; i < n; i++ {
+		switch {
+		case i >= len(got):
+			diff.WriteString("\n\t- " + want[i].String())
+		case i >= len(want):
+			diff.WriteString("\n\t+ " + got[i].String())
+		case got[i] != want[i]:
+			diff.WriteString("\n\t- " + want[i].String() + "\n\t+ " + got[i].String())
+			ok = false
+		default:
+			diff.WriteString("\n\t  " + got[i].String())
+		}
+	}
+	if !ok {
+		t.Errorf("dates differ (-want +got):%s", diff.String())
+	}
+	return ok
+}
+
+// AssertRange reports an error unless got holds every date from start
+// through end, inclusive, in ascending order.
+//
+func AssertRange(t testing.TB, got []epochdate.Date, start, end epochdate.Date) bool {
+	t.Helper()
+	var want []epochdate.Date
+	for d := start; d <= end; d++ {
+		want = append(want, d)
+		if d == end {
+			break
+		}
+	}
+	return AssertDates(t, got, want)
+}
+
+// AssertSet reports an error unless set contains exactly the dates in want,
+// in any order.
+//
+func AssertSet(t testing.TB, set epochdate.DateSet, want ...epochdate.Date) bool {
+	t.Helper()
+	return AssertDates(t, set.Sorted(), epochdate.NewDateSet(want...).Sorted())
+}
+
+// AssertGolden reports an error unless the contents of the golden file at
+// path are identical to got, which may have been produced by FormatDates.
+//
+func AssertGolden(t testing.TB, path, got string) bool {
+	t.Helper()
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Errorf("reading golden file: %v", err)
+		return false
+	}
+	if !bytes.Equal(want, []byte(got)) {
+		t.Errorf("output does not match golden file %s:\ngot:\n%s\nwant:\n%s", path, got, want)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,182 @@
+package epochdatetest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+var _ epochdate.Clock = new(Clock)
+
+// recorder is a testing.TB which records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	failures []string
+	fatal    bool
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...interface{}) {
+	r.Errorf(format, args...)
+	r.fatal = true
+	panic(r)
+}
+
+// record calls fn with a recorder, recovering from calls to Fatalf.
+func record(t *testing.T, fn func(tb testing.TB)) *recorder {
+	r := &recorder{TB: t}
+	func() {
+		defer func() {
+			if v := recover(); v != nil && v != r {
+				panic(v)
+			}
+		}()
+		fn(r)
+	}()
+	return r
+}
+
+func TestClock(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	c := NewClockAt(MustDate(t, "2024-02-28"), loc)
+
+	if got, want := epochdate.TodayFrom(c), MustDate(t, "2024-02-28"); got != want {
+		t.Errorf("TodayFrom(%q) = %q, want %q", c.Now(), got, want)
+	}
+
+	c.AdvanceDays(1)
+	if got, want := epochdate.TodayFrom(c), MustDate(t, "2024-02-29"); got != want {
+		t.Errorf("TodayFrom(%q) = %q, want %q", c.Now(), got, want)
+	}
+
+	c.Advance(12 * time.Hour)
+	if got, want := epochdate.TodayFrom(c), MustDate(t, "2024-03-01"); got != want {
+		t.Errorf("TodayFrom(%q) = %q, want %q", c.Now(), got, want)
+	}
+
+	now := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.Set(now)
+	if got := c.Now(); !got.Equal(now) {
+		t.Errorf("Clock.Now() = %q, want %q", got, now)
+	}
+}
+
+func TestMustDate(t *testing.T) {
+	r := record(t, func(tb testing.TB) {
+		if got, want := MustDate(tb, "1970-01-02"), epochdate.Date(1); got != want {
+			t.Errorf("MustDate(%q) = %q, want %q", "1970-01-02", got, want)
+		}
+	})
+	if len(r.failures) != 0 {
+		t.Errorf("MustDate failed unexpectedly: %q", r.failures)
+	}
+
+	r = record(t, func(tb testing.TB) {
+		MustDates(tb, "1970-01-01", "blah")
+		t.Errorf("MustDates should not return after failing")
+	})
+	if !r.fatal {
+		t.Errorf("MustDates(%q) did not fail the test", "blah")
+	}
+}
+
+func TestAssertDates(t *testing.T) {
+	dates := []epochdate.Date{1, 2, 3}
+
+	tests := []struct {
+		name string
+		fn   func(tb testing.TB) bool
+		want bool
+	}{
+		{
+			name: "equal",
+			fn:   func(tb testing.TB) bool { return AssertDates(tb, dates, []epochdate.Date{1, 2, 3}) },
+			want: true,
+		},
+		{
+			name: "different",
+			fn:   func(tb testing.TB) bool { return AssertDates(tb, dates, []epochdate.Date{1, 3, 3}) },
+			want: false,
+		},
+		{
+			name: "shorter",
+			fn:   func(tb testing.TB) bool { return AssertDates(tb, dates, []epochdate.Date{1, 2}) },
+			want: false,
+		},
+		{
+			name: "range",
+			fn:   func(tb testing.TB) bool { return AssertRange(tb, dates, 1, 3) },
+			want: true,
+		},
+		{
+			name: "range_gap",
+			fn:   func(tb testing.TB) bool { return AssertRange(tb, []epochdate.Date{1, 3}, 1, 3) },
+			want: false,
+		},
+		{
+			name: "range_max",
+			fn: func(tb testing.TB) bool {
+				return AssertRange(tb, []epochdate.Date{65534, 65535}, 65534, 65535)
+			},
+			want: true,
+		},
+		{
+			name: "set",
+			fn:   func(tb testing.TB) bool { return AssertSet(tb, epochdate.NewDateSet(3, 1), 1, 3) },
+			want: true,
+		},
+		{
+			name: "set_missing",
+			fn:   func(tb testing.TB) bool { return AssertSet(tb, epochdate.NewDateSet(3), 1, 3) },
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bool
+			r := record(t, func(tb testing.TB) { got = tt.fn(tb) })
+			if got != tt.want || (len(r.failures) == 0) != tt.want {
+				t.Errorf("assertion returned %v with failures %q, want %v", got, r.failures, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	dir, err := ioutil.TempDir("", "epochdatetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "dates.golden")
+	if err := ioutil.WriteFile(path, []byte("1970-01-01\n1970-01-02\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	got := FormatDates([]epochdate.Date{0, 1})
+	if !AssertGolden(t, path, got) {
+		return
+	}
+
+	r := record(t, func(tb testing.TB) { AssertGolden(tb, path, FormatDates([]epochdate.Date{0})) })
+	if len(r.failures) == 0 {
+		t.Errorf("AssertGolden did not report a mismatch")
+	}
+
+	r = record(t, func(tb testing.TB) { AssertGolden(tb, filepath.Join(dir, "missing"), got) })
+	if len(r.failures) == 0 {
+		t.Errorf("AssertGolden did not report a missing file")
+	}
+}
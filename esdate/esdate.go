@@ -0,0 +1,86 @@
+// Package esdate helps index and query Date and YearMonth fields in
+// Elasticsearch and OpenSearch. Mappings and queries are returned as maps,
+// ready to be encoded to JSON in request bodies, or merged into larger ones
+// built by hand or by a client library.
+//
+//	body := map[string]interface{}{
+//		"mappings": map[string]interface{}{
+//			"properties": map[string]interface{}{
+//				"settled": esdate.DateMapping(),
+//			},
+//		},
+//	}
+//
+//	query, err := esdate.RangeQuery("settled", from, to, nil)
+//
+package esdate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+var errLocation = errors.New("esdate: time zone must be an IANA location, not Local")
+
+// Built-in Elasticsearch formats matching the JSON encodings of Date and
+// YearMonth.
+//
+const (
+	DateFormat      = "strict_date"       // yyyy-MM-dd
+	YearMonthFormat = "strict_year_month" // yyyy-MM
+)
+
+// DateMapping returns the field mapping for Date values.
+func DateMapping() map[string]interface{} {
+	return map[string]interface{}{"type": "date", "format": DateFormat}
+}
+
+// YearMonthMapping returns the field mapping for YearMonth values, which
+// are indexed as the first instant of the month.
+//
+func YearMonthMapping() map[string]interface{} {
+	return map[string]interface{}{"type": "date", "format": YearMonthFormat}
+}
+
+// RangeQuery returns a range query matching the dates from start through
+// end, inclusive. It may be used with fields mapped by DateMapping, and also
+// with date-time fields, in which case whole days are matched: end is
+// rounded up to its last millisecond. For date-time fields, loc specifies
+// the time zone of the days; it may be nil for UTC, and otherwise must be an
+// IANA location, such as one returned by time.LoadLocation, since its name
+// is sent to the server. An error is returned for time.Local, whose name,
+// "Local", Elasticsearch rejects.
+//
+func RangeQuery(field string, start, end epochdate.Date, loc *time.Location) (map[string]interface{}, error) {
+	r := map[string]interface{}{
+		"gte":    start.String(),
+		"lte":    end.String() + "||/d",
+		"format": DateFormat,
+	}
+	if loc != nil && loc != time.UTC {
+		name := loc.String()
+		if loc == time.Local || name == "Local" || name == "" {
+			return nil, errLocation
+		}
+		r["time_zone"] = name
+	}
+	return map[string]interface{}{
+		"range": map[string]interface{}{field: r},
+	}, nil
+}
+
+// DayQuery returns a query matching the single date d. It is shorthand for
+// RangeQuery(field, d, d, loc).
+//
+func DayQuery(field string, d epochdate.Date, loc *time.Location) (map[string]interface{}, error) {
+	return RangeQuery(field, d, d, loc)
+}
+
+// MonthQuery returns a query matching the dates of the month ym. The month
+// must be compatible with Date, as reported by YearMonth.Validate.
+//
+func MonthQuery(field string, ym epochdate.YearMonth, loc *time.Location) (map[string]interface{}, error) {
+	return RangeQuery(field, ym.StartDate(), ym.EndDate(), loc)
+}
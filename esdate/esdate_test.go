@@ -0,0 +1,80 @@
+package esdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+func toJSON(t *testing.T, v interface{}) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestMappings(t *testing.T) {
+	if got, want := toJSON(t, DateMapping()), `{"format":"strict_date","type":"date"}`; got != want {
+		t.Errorf("DateMapping() = %s, want %s", got, want)
+	}
+	if got, want := toJSON(t, YearMonthMapping()), `{"format":"strict_year_month","type":"date"}`; got != want {
+		t.Errorf("YearMonthMapping() = %s, want %s", got, want)
+	}
+
+	// Documents encoded by epochdate must match the mapped formats.
+	doc := toJSON(t, struct {
+		D  epochdate.Date
+		YM epochdate.YearMonth
+	}{epochdate.MustParseRFC("2024-03-01"), epochdate.MustParseRFC("2024-03-01").YearMonth()})
+	if want := `{"D":"2024-03-01","YM":"2024-03"}`; doc != want {
+		t.Errorf("document = %s, want %s", doc, want)
+	}
+}
+
+func TestQueries(t *testing.T) {
+	d := epochdate.MustParseRFC
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	query := func(q map[string]interface{}, err error) map[string]interface{} {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return q
+	}
+	tests := []struct {
+		q    map[string]interface{}
+		want string
+	}{
+		{
+			query(RangeQuery("settled", d("2024-03-01"), d("2024-03-31"), nil)),
+			`{"range":{"settled":{"format":"strict_date","gte":"2024-03-01","lte":"2024-03-31||/d"}}}`,
+		},
+		{
+			query(DayQuery("settled", d("2024-03-01"), tokyo)),
+			`{"range":{"settled":{"format":"strict_date","gte":"2024-03-01","lte":"2024-03-01||/d","time_zone":"Asia/Tokyo"}}}`,
+		},
+		{
+			query(MonthQuery("settled", d("2024-02-10").YearMonth(), time.UTC)),
+			`{"range":{"settled":{"format":"strict_date","gte":"2024-02-01","lte":"2024-02-29||/d"}}}`,
+		},
+	}
+	for _, tt := range tests {
+		if got := toJSON(t, tt.q); got != tt.want {
+			t.Errorf("query = %s\nwant %s", got, tt.want)
+		}
+	}
+
+	for _, loc := range []*time.Location{time.Local, time.FixedZone("Local", 0), time.FixedZone("", 3600)} {
+		if q, err := DayQuery("settled", d("2024-03-01"), loc); err == nil {
+			t.Errorf("DayQuery(%q) = %s, want error", loc, toJSON(t, q))
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package epochdate
+
+// Expiry describes when something dated, such as a license, certificate, or
+// subscription, stops being valid. It is valid through On, the expiry date
+// as commonly printed, and expired from the following day, unless a grace
+// period allows continued use for some days afterward.
+//
+type Expiry struct {
+	// On is the last date of validity.
+	On Date
+
+	// Grace is the number of days after On during which the expired item
+	// is still accepted, such as while a renewal is processed.
+	Grace int
+}
+
+// ExpiresOn returns an Expiry on d, without a grace period.
+func ExpiresOn(d Date) Expiry {
+	return Expiry{On: d}
+}
+
+// WithGrace returns a copy of e with a grace period of the given number of
+// days.
+//
+func (e Expiry) WithGrace(days int) Expiry {
+	e.Grace = days
+	return e
+}
+
+// IsExpiredAt reports whether e has expired, and its grace period has
+// elapsed, by the date d.
+//
+func (e Expiry) IsExpiredAt(d Date) bool {
+	return int(d) > int(e.On)+e.Grace
+}
+
+// InGraceAt reports whether d falls in the grace period of e: after On, but
+// before the item is finally expired.
+//
+func (e Expiry) InGraceAt(d Date) bool {
+	return d > e.On && !e.IsExpiredAt(d)
+}
+
+// IsExpired reports whether e has expired, and its grace period has
+// elapsed, as of today according to c. See TodayFrom for how today is
+// determined.
+//
+func (e Expiry) IsExpired(c Clock) bool {
+	return e.IsExpiredAt(TodayFrom(c))
+}
+
+// InGrace reports whether today, according to c, falls in the grace period
+// of e.
+//
+func (e Expiry) InGrace(c Clock) bool {
+	return e.InGraceAt(TodayFrom(c))
+}
+
+// DaysUntilExpiry returns the number of days from today, according to c,
+// until On, ignoring the grace period: it is zero on the last day of
+// validity, and negative once e has expired.
+//
+func (e Expiry) DaysUntilExpiry(c Clock) int {
+	return e.On.Until(c)
+}
@@ -0,0 +1,40 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpiry(t *testing.T) {
+	e := ExpiresOn(MustParseRFC("2024-12-31")).WithGrace(14)
+	tests := []struct {
+		today          string
+		expired, grace bool
+		days           int
+	}{
+		{"2024-12-01", false, false, 30},
+		{"2024-12-31", false, false, 0},
+		{"2025-01-01", false, true, -1},
+		{"2025-01-14", false, true, -14},
+		{"2025-01-15", true, false, -15},
+	}
+	for _, tt := range tests {
+		c := fixedClock(MustParseRFC(tt.today).UTC().Add(12 * time.Hour))
+		if got := e.IsExpired(c); got != tt.expired {
+			t.Errorf("on %s, IsExpired() = %v; want %v", tt.today, got, tt.expired)
+		}
+		if got := e.InGrace(c); got != tt.grace {
+			t.Errorf("on %s, InGrace() = %v; want %v", tt.today, got, tt.grace)
+		}
+		if got := e.DaysUntilExpiry(c); got != tt.days {
+			t.Errorf("on %s, DaysUntilExpiry() = %d; want %d", tt.today, got, tt.days)
+		}
+	}
+
+	if e := ExpiresOn(maxDate).WithGrace(10); e.IsExpiredAt(maxDate) || e.InGraceAt(maxDate) {
+		t.Errorf("expiry at the maximum date misbehaves")
+	}
+	if !ExpiresOn(10).IsExpiredAt(11) {
+		t.Errorf("expiry without grace is not expired the following day")
+	}
+}
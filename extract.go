@@ -0,0 +1,171 @@
+package epochdate
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateMatch is a date found in text by a DateScanner. The date was written
+// as text[Start:End].
+//
+type DateMatch struct {
+	Start, End int
+	Date       Date
+}
+
+// DateScanner finds dates mentioned in free-form text, such as log files and
+// documents. It recognizes the following forms, where the separator of
+// numeric dates may be "-", "/", or ".", and is the same throughout a date:
+//
+//	2024-03-01      year, month, and day, as in RFC 3339
+//	2024-03-01T...  the date of an RFC 3339 timestamp
+//	01/03/2024      day or month first, according to DayFirst
+//
+// If MonthNames is set, it also recognizes English month names and their
+// three-letter abbreviations, ignoring case:
+//
+//	1 March 2024, 1st Mar 2024, 1-Mar-2024
+//	March 1, 2024, Mar 1st 2024
+//
+// Dates must not be adjacent to other letters or digits. Mentions of dates
+// which do not exist, such as 2023-02-29, or which are not representable as
+// Dates, are ignored. The zero value finds numeric dates, month first.
+//
+type DateScanner struct {
+	// DayFirst reads numeric dates which do not begin with the year as
+	// day/month/year, rather than month/day/year.
+	DayFirst bool
+
+	// MonthNames enables recognition of dates with month names.
+	MonthNames bool
+}
+
+const monthPattern = `(jan(?:uary)?|feb(?:ruary)?|mar(?:ch)?|apr(?:il)?|may|june?|july?|aug(?:ust)?|sep(?:t(?:ember)?)?|oct(?:ober)?|nov(?:ember)?|dec(?:ember)?)\.?`
+
+var (
+	yearFirstRE = regexp.MustCompile(`(\d{4})([-/.])(\d{1,2})([-/.])(\d{1,2})`)
+	yearLastRE  = regexp.MustCompile(`(\d{1,2})([-/.])(\d{1,2})([-/.])(\d{4})`)
+	dayMonthRE  = regexp.MustCompile(`(?i)(\d{1,2})(?:st|nd|rd|th)?(?:\s+of)?(?:\s+|-)` + monthPattern + `(?:,?\s+|-)(\d{4})`)
+	monthDayRE  = regexp.MustCompile(`(?i)` + monthPattern + `\s+(\d{1,2})(?:st|nd|rd|th)?(?:,?\s+)(\d{4})`)
+)
+
+// FindAll returns the dates mentioned in text, in order of occurrence.
+// Mentions do not overlap; where forms overlap, the earliest, and then the
+// longest, is found.
+//
+func (s DateScanner) FindAll(text string) []DateMatch {
+	var matches []DateMatch
+	add := func(re *regexp.Regexp, date func(sub []string) (Date, bool)) {
+		for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if !bounded(text, start, end) {
+				continue
+			}
+			sub := make([]string, len(loc)/2)
+			for i := range sub {
+				sub[i] = text[loc[2*i]:loc[2*i+1]]
+			}
+			if d, ok := date(sub); ok {
+				matches = append(matches, DateMatch{Start: start, End: end, Date: d})
+			}
+		}
+	}
+
+	add(yearFirstRE, func(sub []string) (Date, bool) {
+		if sub[2] != sub[4] {
+			return 0, false
+		}
+		return extractDate(sub[1], sub[3], sub[5])
+	})
+	add(yearLastRE, func(sub []string) (Date, bool) {
+		if sub[2] != sub[4] {
+			return 0, false
+		}
+		if s.DayFirst {
+			return extractDate(sub[5], sub[3], sub[1])
+		}
+		return extractDate(sub[5], sub[1], sub[3])
+	})
+	if s.MonthNames {
+		add(dayMonthRE, func(sub []string) (Date, bool) {
+			return extractDate(sub[3], monthNumber(sub[2]), sub[1])
+		})
+		add(monthDayRE, func(sub []string) (Date, bool) {
+			return extractDate(sub[3], monthNumber(sub[1]), sub[2])
+		})
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Start != matches[j].Start {
+			return matches[i].Start < matches[j].Start
+		}
+		return matches[i].End > matches[j].End
+	})
+	out := matches[:0]
+	for _, m := range matches {
+		if len(out) == 0 || m.Start >= out[len(out)-1].End {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// Find returns the first date mentioned in text, if any.
+func (s DateScanner) Find(text string) (DateMatch, bool) {
+	matches := s.FindAll(text)
+	if len(matches) == 0 {
+		return DateMatch{}, false
+	}
+	return matches[0], true
+}
+
+// bounded reports whether text[start:end] is not adjacent to letters or
+// digits, except for the "T" beginning the time of an RFC 3339 timestamp.
+//
+func bounded(text string, start, end int) bool {
+	if start > 0 && isAlnum(text[start-1]) {
+		return false
+	}
+	if end < len(text) && isAlnum(text[end]) {
+		return text[end] == 'T' && end+1 < len(text) && isDigit(text[end+1])
+	}
+	return true
+}
+
+func isAlnum(c byte) bool {
+	return isDigit(c) || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+// monthNumber returns the number of the named month, which must match
+// monthPattern.
+//
+func monthNumber(name string) string {
+	prefix := strings.ToLower(name[:3])
+	for m := time.January; m <= time.December; m++ {
+		if strings.ToLower(m.String()[:3]) == prefix {
+			return strconv.Itoa(int(m))
+		}
+	}
+	return ""
+}
+
+// extractDate returns the date with the given decimal year, month, and day
+// strings, and whether it exists and is representable.
+//
+func extractDate(ys, ms, ds string) (Date, bool) {
+	y, err1 := strconv.Atoi(ys)
+	m, err2 := strconv.Atoi(ms)
+	dom, err3 := strconv.Atoi(ds)
+	if err1 != nil || err2 != nil || err3 != nil ||
+		m < 1 || m > 12 || dom < 1 || dom > daysIn(y, time.Month(m)) {
+		return 0, false
+	}
+	seconds := time.Date(y, time.Month(m), dom, 0, 0, 0, 0, time.UTC).Unix()
+	if !UnixInRange(seconds) {
+		return 0, false
+	}
+	return Date(seconds / day), true
+}
@@ -0,0 +1,69 @@
+package epochdate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDateScanner_FindAll(t *testing.T) {
+	type found struct {
+		text string
+		date string
+	}
+	tests := []struct {
+		scanner DateScanner
+		text    string
+		want    []found
+	}{
+		{
+			text: "2024-03-01T12:00:00Z ERROR job started 2024/02/29, retried 03/02/2024",
+			want: []found{{"2024-03-01", "2024-03-01"}, {"2024/02/29", "2024-02-29"}, {"03/02/2024", "2024-03-02"}},
+		},
+		{
+			scanner: DateScanner{DayFirst: true},
+			text:    "due 03.02.2024.",
+			want:    []found{{"03.02.2024", "2024-02-03"}},
+		},
+		{
+			text: "2023-02-29 13/13/2024 2024-03/01 12024-01-01 2024-01-012 1969-12-31 v2024-01-01",
+		},
+		{
+			text: "1 March 2024 and March 1, 2024",
+		},
+		{
+			scanner: DateScanner{MonthNames: true},
+			text:    "Signed on the 1st of March 2024, effective Mar. 4th 2024 until 31-DEC-2025 (see 2026-01-01).",
+			want: []found{
+				{"1st of March 2024", "2024-03-01"},
+				{"Mar. 4th 2024", "2024-03-04"},
+				{"31-DEC-2025", "2025-12-31"},
+				{"2026-01-01", "2026-01-01"},
+			},
+		},
+		{
+			scanner: DateScanner{MonthNames: true},
+			text:    "Sept 30, 2024; 31 June 2024; Maybe 1 2024",
+			want:    []found{{"Sept 30, 2024", "2024-09-30"}},
+		},
+	}
+	for _, test := range tests {
+		var got []found
+		for _, m := range test.scanner.FindAll(test.text) {
+			got = append(got, found{test.text[m.Start:m.End], m.Date.String()})
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%+v.FindAll(%q) = %q; want %q", test.scanner, test.text, got, test.want)
+		}
+	}
+}
+
+func TestDateScanner_Find(t *testing.T) {
+	var s DateScanner
+	m, ok := s.Find("[2024-05-06 10:00] ok")
+	if !ok || m != (DateMatch{Start: 1, End: 11, Date: MustParseRFC("2024-05-06")}) {
+		t.Errorf("Find = %+v, %v", m, ok)
+	}
+	if m, ok := s.Find("no dates here"); ok {
+		t.Errorf("Find = %+v; want no match", m)
+	}
+}
@@ -0,0 +1,165 @@
+package epochdate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errQuarter = errors.New("epochdate: quarter must be in range [1,4]")
+
+// FiscalCalendar defines fiscal years which begin in a month other than
+// January, such as the United States federal fiscal year, which begins in
+// October. The zero value is the calendar year.
+//
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year. Zero is
+	// equivalent to January.
+	StartMonth time.Month
+
+	// NamedByStart names fiscal years by the calendar year in which they
+	// begin, rather than the more common convention of the calendar year
+	// in which they end: with an October start, the year from 2023-10-01
+	// through 2024-09-30 is FY2024 by default, or FY2023 if NamedByStart is
+	// set.
+	NamedByStart bool
+}
+
+func (c FiscalCalendar) startMonth() time.Month {
+	if c.StartMonth < time.January || c.StartMonth > time.December {
+		return time.January
+	}
+	return c.StartMonth
+}
+
+// YearQuarter identifies a quarter of a fiscal (or calendar) year, with
+// Quarter in the range [1,4].
+//
+type YearQuarter struct {
+	Year    int
+	Quarter int
+}
+
+// Quarter returns the fiscal quarter containing d.
+func (c FiscalCalendar) Quarter(d Date) YearQuarter {
+	year, month, _ := d.Date()
+	start := c.startMonth()
+	offset := (int(month) - int(start) + 12) % 12
+	if month < start {
+		year-- // the fiscal year began in the previous calendar year
+	}
+	if start != time.January && !c.NamedByStart {
+		year++
+	}
+	return YearQuarter{Year: year, Quarter: offset/3 + 1}
+}
+
+// Range returns the dates of the fiscal quarter q. The range is clamped to
+// the representable dates if q is partially representable, and
+// ErrOutOfRange is returned if it is not representable at all.
+//
+func (c FiscalCalendar) Range(q YearQuarter) (DateRange, error) {
+	if q.Quarter < 1 || q.Quarter > 4 {
+		return DateRange{}, errQuarter
+	}
+	start := c.startMonth()
+	year := q.Year
+	if start != time.January && !c.NamedByStart {
+		year--
+	}
+	month := start + time.Month(3*(q.Quarter-1))
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 3, -1)
+	if first.Unix() > maxUnix || last.Unix() < 0 {
+		return DateRange{}, ErrOutOfRange
+	}
+	return DateRange{Start: ClampFromTime(first), End: ClampFromTime(last)}, nil
+}
+
+// QuarterStyle selects the form of quarter labels.
+type QuarterStyle int
+
+const (
+	// QuarterFY labels quarters as in "FY24 Q3".
+	QuarterFY QuarterStyle = iota
+
+	// QuarterDash labels quarters as in "Q3-2025".
+	QuarterDash
+)
+
+// Label returns q labeled in the given style.
+func (q YearQuarter) Label(style QuarterStyle) string {
+	if style == QuarterDash {
+		return fmt.Sprintf("Q%d-%d", q.Quarter, q.Year)
+	}
+	return fmt.Sprintf("FY%02d Q%d", q.Year%100, q.Quarter)
+}
+
+// String returns q in the QuarterDash style, such as "Q3-2025", which
+// unlike the QuarterFY style includes the full year.
+//
+func (q YearQuarter) String() string {
+	return q.Label(QuarterDash)
+}
+
+// ParseQuarterLabel parses a quarter label in either style accepted by
+// Label, or with a four-digit year in the QuarterFY style, as in
+// "FY2024 Q3". Two-digit years are interpreted as 1970 through 2069. The
+// parsing is case-insensitive.
+//
+func ParseQuarterLabel(s string) (YearQuarter, error) {
+	errSyntax := fmt.Errorf("epochdate: invalid quarter label %q", s)
+	u := strings.ToUpper(strings.TrimSpace(s))
+	var year, quarter string
+	switch {
+	case strings.HasPrefix(u, "FY"):
+		f := strings.Fields(u[2:])
+		if len(f) != 2 || !strings.HasPrefix(f[1], "Q") {
+			return YearQuarter{}, errSyntax
+		}
+		year, quarter = f[0], f[1][1:]
+		if len(year) != 2 && len(year) != 4 {
+			return YearQuarter{}, errSyntax
+		}
+	case strings.HasPrefix(u, "Q"):
+		i := strings.IndexByte(u, '-')
+		if i < 0 {
+			return YearQuarter{}, errSyntax
+		}
+		quarter, year = u[1:i], u[i+1:]
+		if len(year) != 4 {
+			return YearQuarter{}, errSyntax
+		}
+	default:
+		return YearQuarter{}, errSyntax
+	}
+
+	y, err := strconv.Atoi(year)
+	if err != nil || y < 0 || !isDigit(year[0]) {
+		return YearQuarter{}, errSyntax
+	}
+	if len(year) == 2 {
+		y += 1900
+		if y < minYear {
+			y += 100
+		}
+	}
+	q, err := strconv.Atoi(quarter)
+	if err != nil || len(quarter) != 1 || q < 1 || q > 4 {
+		return YearQuarter{}, errSyntax
+	}
+	return YearQuarter{Year: y, Quarter: q}, nil
+}
+
+// ParseRange parses a quarter label, as by ParseQuarterLabel, and returns
+// the dates of that fiscal quarter, as by Range.
+//
+func (c FiscalCalendar) ParseRange(label string) (DateRange, error) {
+	q, err := ParseQuarterLabel(label)
+	if err != nil {
+		return DateRange{}, err
+	}
+	return c.Range(q)
+}
@@ -0,0 +1,82 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFiscalCalendar_Quarter(t *testing.T) {
+	federal := FiscalCalendar{StartMonth: time.October}
+	byStart := FiscalCalendar{StartMonth: time.October, NamedByStart: true}
+	tests := []struct {
+		cal  FiscalCalendar
+		date string
+		want YearQuarter
+	}{
+		{FiscalCalendar{}, "2024-01-01", YearQuarter{2024, 1}},
+		{FiscalCalendar{}, "2024-08-15", YearQuarter{2024, 3}},
+		{federal, "2023-10-01", YearQuarter{2024, 1}},
+		{federal, "2024-04-30", YearQuarter{2024, 3}},
+		{federal, "2024-09-30", YearQuarter{2024, 4}},
+		{federal, "2024-10-01", YearQuarter{2025, 1}},
+		{byStart, "2024-04-30", YearQuarter{2023, 3}},
+		{FiscalCalendar{StartMonth: time.April}, "2024-03-31", YearQuarter{2024, 4}},
+	}
+	for _, tt := range tests {
+		d := MustParseRFC(tt.date)
+		q := tt.cal.Quarter(d)
+		if q != tt.want {
+			t.Errorf("%+v.Quarter(%s) = %v; want %v", tt.cal, tt.date, q, tt.want)
+			continue
+		}
+		r, err := tt.cal.Range(q)
+		if err != nil || d < r.Start || d > r.End || tt.cal.Quarter(r.Start) != q || tt.cal.Quarter(r.End+1) == q {
+			t.Errorf("%+v.Range(%v) = %v, %v", tt.cal, q, r, err)
+		}
+	}
+}
+
+func TestFiscalCalendar_Range(t *testing.T) {
+	federal := FiscalCalendar{StartMonth: time.October}
+	tests := []struct {
+		label string
+		want  string
+	}{
+		{"FY24 Q1", "2023-10-01/2023-12-31"},
+		{"fy2024 q3", "2024-04-01/2024-06-30"},
+		{"Q3-2025", "2025-04-01/2025-06-30"},
+		{"FY70 Q1", "1970-01-01/1970-03-31"},
+		{"FY49 Q3", "2049-04-01/2049-06-30"},
+		{"FY2149 Q3", "2149-04-01/2149-06-06"},
+	}
+	for _, tt := range tests {
+		cal := federal
+		if tt.label == "FY70 Q1" {
+			cal = FiscalCalendar{}
+		}
+		r, err := cal.ParseRange(tt.label)
+		if err != nil || r.String() != tt.want {
+			t.Errorf("ParseRange(%q) = %v, %v; want %s", tt.label, r, err, tt.want)
+		}
+	}
+	for _, q := range []YearQuarter{{1970, 1}, {2150, 1}, {2024, 0}, {2024, 5}} {
+		if r, err := federal.Range(q); err == nil {
+			t.Errorf("Range(%v) = %v; want error", q, r)
+		}
+	}
+}
+
+func TestQuarterLabel(t *testing.T) {
+	q := YearQuarter{2024, 3}
+	if s := q.Label(QuarterFY); s != "FY24 Q3" {
+		t.Errorf("Label(QuarterFY) = %q", s)
+	}
+	if s := q.Label(QuarterDash); s != "Q3-2024" || q.String() != s {
+		t.Errorf("Label(QuarterDash) = %q", s)
+	}
+	for _, s := range []string{"", "FY24", "FY24 Q5", "FY24Q3", "FY2 Q3", "Q3-24", "Q3 2024", "Q-2024", "FY-1 Q1", "FY24 Q03", "2024 Q3"} {
+		if q, err := ParseQuarterLabel(s); err == nil {
+			t.Errorf("ParseQuarterLabel(%q) = %v; want error", s, q)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package epochdate
+
+import "time"
+
+// appendRFC appends d to b in RFC 3339 form, computing the date with
+// integer arithmetic, rather than through time.Time.Format.
+//
+func appendRFC(b []byte, d Date) []byte {
+	year, month, dom := civil(int(d))
+	return append(b,
+		byte('0'+year/1000), byte('0'+year/100%10), byte('0'+year/10%10), byte('0'+year%10), '-',
+		byte('0'+month/10), byte('0'+month%10), '-',
+		byte('0'+dom/10), byte('0'+dom%10))
+}
+
+// AppendFormat is like Format, but appends the textual representation to b
+// and returns the extended buffer. The RFC3339 layout is formatted without
+// allocating.
+//
+func (d Date) AppendFormat(b []byte, layout string) []byte {
+	if layout == RFC3339 {
+		return appendRFC(b, d)
+	}
+	return d.UTC().AppendFormat(b, layout)
+}
+
+// AppendText implements encoding.TextAppender, appending the form returned
+// by MarshalText to b without allocating, unless b must grow.
+//
+func (d Date) AppendText(b []byte) ([]byte, error) {
+	return appendRFC(b, d), nil
+}
+
+// parseRFC parses a date in RFC 3339 form, returning the number of days
+// from 1970-01-01, and true, or false if value is not a valid date.
+//
+func parseRFC(value string) (int, bool) {
+	if len(value) != len(RFC3339) || value[4] != '-' || value[7] != '-' {
+		return 0, false
+	}
+	for _, i := range [...]int{0, 1, 2, 3, 5, 6, 8, 9} {
+		if !isDigit(value[i]) {
+			return 0, false
+		}
+	}
+	year := int(value[0]-'0')*1000 + int(value[1]-'0')*100 + int(value[2]-'0')*10 + int(value[3]-'0')
+	month := int(value[5]-'0')*10 + int(value[6]-'0')
+	dom := int(value[8]-'0')*10 + int(value[9]-'0')
+	// Leave years before any representable date to time.Parse, since
+	// civilDays does not handle them.
+	if year < 1 || month < 1 || month > 12 || dom < 1 || dom > daysIn(year, time.Month(month)) {
+		return 0, false
+	}
+	return civilDays(year, month, dom), true
+}
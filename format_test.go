@@ -0,0 +1,87 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_AppendText(t *testing.T) {
+	for n := 0; n <= maxDate; n++ {
+		d := Date(n)
+		want := time.Unix(int64(n)*day, 0).UTC().Format(RFC3339)
+		if got := d.String(); got != want {
+			t.Fatalf("%d.String() = %q; want %q", n, got, want)
+		}
+		if got, err := d.AppendText([]byte("x")); err != nil || string(got) != "x"+want {
+			t.Fatalf("%d.AppendText(x) = %q, %v; want %q", n, got, err, "x"+want)
+		}
+	}
+
+	d := MustParseRFC("2024-03-01")
+	if got := string(d.AppendFormat(nil, "Jan 2, 2006")); got != "Mar 1, 2024" {
+		t.Errorf("AppendFormat = %q; want %q", got, "Mar 1, 2024")
+	}
+	if got, _ := d.MarshalJSON(); string(got) != `"2024-03-01"` {
+		t.Errorf("MarshalJSON = %s", got)
+	}
+}
+
+func TestDate_AppendText_allocs(t *testing.T) {
+	d := MustParseRFC("2024-03-01")
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = d.AppendText(buf[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("AppendText allocated %v times; want 0", allocs)
+	}
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = d.String()
+	})
+	if allocs > 1 {
+		t.Errorf("String allocated %v times; want at most 1", allocs)
+	}
+}
+
+func TestParseRFC(t *testing.T) {
+	for n := 0; n <= maxDate; n++ {
+		s := Date(n).String()
+		if d, err := ParseRFC(s); err != nil || d != Date(n) {
+			t.Fatalf("ParseRFC(%q) = %d, %v; want %d", s, d, err, n)
+		}
+	}
+
+	invalid := []string{
+		"", "2024-3-01", "2024-03-1", "2024/03/01", "2024-03-01 ", "+024-03-01",
+		"2024-00-01", "2024-13-01", "2024-02-30", "2023-02-29", "2024-04-31", "2024-01-00",
+		"2024-0a-01", "２０２４-03-01",
+	}
+	for _, s := range invalid {
+		_, want := time.Parse(RFC3339, s)
+		_, err := ParseRFC(s)
+		if err == nil || want == nil || err.Error() != want.Error() {
+			t.Errorf("ParseRFC(%q) error = %v; want %v", s, err, want)
+		}
+	}
+
+	defer func(clamp bool) { Clamp = clamp }(Clamp)
+	for _, clamp := range []bool{false, true} {
+		Clamp = clamp
+		for _, s := range []string{"0000-01-01", "1969-12-31", "2149-06-07", "9999-12-31"} {
+			want, wantErr := Parse(RFC3339, s)
+			got, err := ParseRFC(s)
+			if got != want || err != wantErr {
+				t.Errorf("Clamp %v: ParseRFC(%q) = %d, %v; want %d, %v", clamp, s, got, err, want, wantErr)
+			}
+		}
+	}
+}
+
+func TestParseRFC_allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = ParseRFC("2024-03-01")
+	})
+	if allocs != 0 {
+		t.Errorf("ParseRFC allocated %v times; want 0", allocs)
+	}
+}
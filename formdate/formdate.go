@@ -0,0 +1,157 @@
+// Package formdate decodes dates from URL query parameters and form values,
+// reporting failures with errors suitable for presenting to the users who
+// supplied them:
+//
+//	from, to, err := formdate.Range(r.URL.Query(), "from", "to")
+//	if err != nil {
+//		http.Error(w, err.Error(), http.StatusBadRequest)
+//		return
+//	}
+//
+// Values may be in any of several layouts, DefaultLayouts unless others are
+// given. Converter adapts the same parsing to decoders which bind form
+// values to struct fields, such as github.com/gorilla/schema:
+//
+//	decoder.RegisterConverter(epochdate.Date(0), formdate.Converter)
+//
+package formdate
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/xtgo/epochdate"
+)
+
+// DefaultLayouts holds the layouts accepted when none are given. They are
+// tried in order, so ambiguous layouts, such as day-first and month-first
+// dates, should not both be included.
+//
+var DefaultLayouts = []string{
+	epochdate.RFC3339,
+	"20060102",
+	"2006/01/02",
+	"01/02/2006",
+	"2 Jan 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+}
+
+var (
+	errSyntax = errors.New("is not a valid date")
+	errOrder  = errors.New("must not be before")
+)
+
+// Error describes a value which could not be decoded. Its message names the
+// parameter and the value, and is intended to be shown to the user who
+// supplied them.
+//
+type Error struct {
+	Param string // the parameter name, or empty if unknown
+	Value string
+	Err   error  // the underlying cause, which may be epochdate.ErrOutOfRange
+	Other string // for ordering errors, the other parameter name
+}
+
+func (e *Error) Error() string {
+	var b strings.Builder
+	if e.Param != "" {
+		b.WriteString(e.Param)
+		b.WriteString(": ")
+	}
+	fmt.Fprintf(&b, "%q ", e.Value)
+	switch e.Err {
+	case epochdate.ErrOutOfRange:
+		fmt.Fprintf(&b, "is outside the supported range of %s to %s", epochdate.Date(0), ^epochdate.Date(0))
+
+	case errOrder:
+		fmt.Fprintf(&b, "must not be before %s", e.Other)
+
+	default:
+		fmt.Fprintf(&b, "is not a valid date; use a date such as %s", epochdate.RFC3339)
+	}
+	return b.String()
+}
+
+// Unwrap returns e.Err.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses value, which is first trimmed of surrounding space, using
+// the first of layouts which matches it, or DefaultLayouts if none are
+// given. Failures are reported as an *Error with an empty Param.
+//
+func Parse(value string, layouts ...string) (epochdate.Date, error) {
+	if len(layouts) == 0 {
+		layouts = DefaultLayouts
+	}
+	s := strings.TrimSpace(value)
+	for _, layout := range layouts {
+		d, err := epochdate.Parse(layout, s)
+		switch err {
+		case nil:
+			return d, nil
+
+		case epochdate.ErrOutOfRange:
+			return 0, &Error{Value: value, Err: err}
+		}
+	}
+	return 0, &Error{Value: value, Err: errSyntax}
+}
+
+// Date decodes the named parameter of values, using Parse. If the parameter
+// is absent or empty, ok is false and err is nil.
+//
+func Date(values url.Values, name string, layouts ...string) (d epochdate.Date, ok bool, err error) {
+	s := values.Get(name)
+	if strings.TrimSpace(s) == "" {
+		return 0, false, nil
+	}
+	d, err = Parse(s, layouts...)
+	if err != nil {
+		err.(*Error).Param = name
+		return 0, false, err
+	}
+	return d, true, nil
+}
+
+// Range decodes the inclusive range of dates given by the named parameters.
+// An absent or empty start defaults to the first representable date, and an
+// absent or empty end to the last. It is an error for the end to precede the
+// start.
+//
+func Range(values url.Values, startName, endName string, layouts ...string) (start, end epochdate.Date, err error) {
+	start, _, err = Date(values, startName, layouts...)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, ok, err := Date(values, endName, layouts...)
+	switch {
+	case err != nil:
+		return 0, 0, err
+
+	case !ok:
+		end = ^epochdate.Date(0)
+
+	case end < start:
+		return 0, 0, &Error{Param: endName, Value: values.Get(endName), Err: errOrder, Other: startName}
+	}
+	return start, end, nil
+}
+
+// Converter parses value using DefaultLayouts, returning the resulting Date,
+// or the zero reflect.Value if it could not be parsed. Its signature matches
+// the converter functions used by github.com/gorilla/schema and similar
+// decoders.
+//
+func Converter(value string) reflect.Value {
+	d, err := Parse(value)
+	if err != nil {
+		return reflect.Value{}
+	}
+	return reflect.ValueOf(d)
+}
@@ -0,0 +1,96 @@
+package formdate
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/xtgo/epochdate"
+)
+
+func TestParse(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	for _, s := range []string{"2024-03-01", " 20240301 ", "2024/03/01", "03/01/2024", "1 Mar 2024", "Mar 1, 2024", "March 1, 2024"} {
+		if d, err := Parse(s); err != nil || d != want {
+			t.Errorf("Parse(%q) = %v, %v; want %v", s, d, err, want)
+		}
+	}
+	if d, err := Parse("01.03.2024", "02.01.2006"); err != nil || d != want {
+		t.Errorf("Parse with layout = %v, %v; want %v", d, err, want)
+	}
+
+	tests := []struct {
+		value string
+		cause error
+		msg   string
+	}{
+		{"2024-13-01", errSyntax, `"2024-13-01" is not a valid date; use a date such as 2006-01-02`},
+		{"1969-12-31", epochdate.ErrOutOfRange, `"1969-12-31" is outside the supported range of 1970-01-01 to 2149-06-06`},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.value)
+		if !errors.Is(err, tt.cause) || err.Error() != tt.msg {
+			t.Errorf("Parse(%q) error = %v, want %s", tt.value, err, tt.msg)
+		}
+	}
+}
+
+func TestDate(t *testing.T) {
+	values := url.Values{"from": {"2024-03-01"}, "empty": {""}, "bad": {"soon"}}
+
+	if d, ok, err := Date(values, "from"); err != nil || !ok || d != epochdate.MustParseRFC("2024-03-01") {
+		t.Errorf("Date(from) = %v, %v, %v", d, ok, err)
+	}
+	for _, name := range []string{"empty", "missing"} {
+		if _, ok, err := Date(values, name); err != nil || ok {
+			t.Errorf("Date(%s) = %v, %v; want not ok", name, ok, err)
+		}
+	}
+
+	_, _, err := Date(values, "bad")
+	var e *Error
+	if !errors.As(err, &e) || e.Param != "bad" || e.Value != "soon" {
+		t.Fatalf("Date(bad) error = %#v", err)
+	}
+	if want := `bad: "soon" is not a valid date; use a date such as 2006-01-02`; err.Error() != want {
+		t.Errorf("Error() = %s, want %s", err, want)
+	}
+}
+
+func TestRange(t *testing.T) {
+	d := epochdate.MustParseRFC
+	tests := []struct {
+		query      string
+		start, end epochdate.Date
+		err        string
+	}{
+		{"from=2024-03-01&to=2024-03-31", d("2024-03-01"), d("2024-03-31"), ""},
+		{"from=2024-03-01&to=2024-03-01", d("2024-03-01"), d("2024-03-01"), ""},
+		{"from=2024-03-01", d("2024-03-01"), d("2149-06-06"), ""},
+		{"to=2024-03-31", d("1970-01-01"), d("2024-03-31"), ""},
+		{"", d("1970-01-01"), d("2149-06-06"), ""},
+		{"from=2024-03-02&to=2024-03-01", 0, 0, `to: "2024-03-01" must not be before from`},
+		{"from=x&to=2024-03-01", 0, 0, `from: "x" is not a valid date; use a date such as 2006-01-02`},
+		{"from=2024-03-01&to=x", 0, 0, `to: "x" is not a valid date; use a date such as 2006-01-02`},
+	}
+	for _, tt := range tests {
+		values, _ := url.ParseQuery(tt.query)
+		start, end, err := Range(values, "from", "to")
+		var msg string
+		if err != nil {
+			msg = err.Error()
+		}
+		if start != tt.start || end != tt.end || msg != tt.err {
+			t.Errorf("Range(%q) = %v, %v, %q; want %v, %v, %q", tt.query, start, end, msg, tt.start, tt.end, tt.err)
+		}
+	}
+}
+
+func TestConverter(t *testing.T) {
+	if v := Converter("2024-03-01"); !v.IsValid() || v.Interface() != epochdate.MustParseRFC("2024-03-01") {
+		t.Errorf("Converter(2024-03-01) = %v", v)
+	}
+	if v := Converter("x"); v.IsValid() {
+		t.Errorf("Converter(x) = %v, want invalid", v)
+	}
+}
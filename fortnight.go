@@ -0,0 +1,46 @@
+package epochdate
+
+// Fortnight returns the index of the 14-day period containing d, for
+// periods beginning on anchor and every 14 days before and after it. The
+// period beginning on anchor has index 0, and periods before it have
+// negative indexes. This suits biweekly cadences, such as pay periods and
+// sprints, which are defined by any one of their start dates.
+//
+func (d Date) Fortnight(anchor Date) int {
+	n := int(d) - int(anchor)
+	if n < 0 {
+		return (n - 13) / 14
+	}
+	return n / 14
+}
+
+// FortnightStart returns the first date of the 14-day period containing d,
+// for periods aligned with anchor, as for Fortnight. The result is clamped
+// to the representable range, which it may precede when d is in the first
+// days of that range.
+//
+func (d Date) FortnightStart(anchor Date) Date {
+	return clampDays(int(anchor) + 14*d.Fortnight(anchor))
+}
+
+// FortnightEnd returns the last date of the 14-day period containing d, for
+// periods aligned with anchor, as for Fortnight. The result is clamped to
+// the representable range.
+//
+func (d Date) FortnightEnd(anchor Date) Date {
+	return clampDays(int(anchor) + 14*d.Fortnight(anchor) + 13)
+}
+
+// clampDays returns the Date which is n days after 1970-01-01, clamped to
+// the representable range.
+//
+func clampDays(n int) Date {
+	switch {
+	case n < 0:
+		return 0
+
+	case n > maxDate:
+		return maxDate
+	}
+	return Date(n)
+}
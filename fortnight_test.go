@@ -0,0 +1,46 @@
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestFortnight(t *testing.T) {
+	anchor := MustParseRFC("2024-03-01")
+	tests := []struct {
+		date       string
+		index      int
+		start, end string
+	}{
+		{"2024-03-01", 0, "2024-03-01", "2024-03-14"},
+		{"2024-03-14", 0, "2024-03-01", "2024-03-14"},
+		{"2024-03-15", 1, "2024-03-15", "2024-03-28"},
+		{"2024-02-29", -1, "2024-02-16", "2024-02-29"},
+		{"2024-02-16", -1, "2024-02-16", "2024-02-29"},
+		{"2024-02-15", -2, "2024-02-02", "2024-02-15"},
+		{"1970-01-01", -1414, "1970-01-01", "1970-01-01"},
+		{"2149-06-06", 3268, "2149-06-06", "2149-06-06"},
+	}
+	for _, tt := range tests {
+		d := MustParseRFC(tt.date)
+		if got := d.Fortnight(anchor); got != tt.index {
+			t.Errorf("%s.Fortnight() = %d, want %d", tt.date, got, tt.index)
+		}
+		if got := d.FortnightStart(anchor); got.String() != tt.start {
+			t.Errorf("%s.FortnightStart() = %v, want %s", tt.date, got, tt.start)
+		}
+		if got := d.FortnightEnd(anchor); got.String() != tt.end {
+			t.Errorf("%s.FortnightEnd() = %v, want %s", tt.date, got, tt.end)
+		}
+	}
+
+	f := func(d, anchor Date) bool {
+		start, end := d.FortnightStart(anchor), d.FortnightEnd(anchor)
+		return start <= d && d <= end && end-start <= 13 &&
+			start.Fortnight(anchor) == d.Fortnight(anchor) &&
+			end.Fortnight(anchor) == d.Fortnight(anchor)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/xtgo/epochdate
 
-go 1.12
+go 1.18
@@ -0,0 +1,386 @@
+// Package gormdate provides epochdate types for use in GORM models. Date
+// and NullDate map to DATE columns on every dialect, and scan from the
+// time.Time, string, []byte, int64, and float64 values returned by common
+// drivers, including the SQLite representations listed below:
+//
+//	type Invoice struct {
+//		ID      uint
+//		Issued  gormdate.Date
+//		Settled gormdate.NullDate
+//	}
+//
+// MySQL's zero date, "0000-00-00", fails to scan into Date and NullDate.
+// Legacy schemas which use zero dates in place of NULL may instead declare
+// fields as MySQLDate or NullMySQLDate, which accept them, so that the
+// choice is made per field.
+//
+// SQLite has no date type, but documents conventions for storing dates as
+// TEXT, REAL, or INTEGER values. Date and NullDate are written as time.Time,
+// which SQLite drivers store as text in a driver-specific layout, such as
+// "2006-01-02 15:04:05+00:00". Fields declared as SQLiteText, SQLiteJulianDay,
+// or SQLiteUnixDays, or their Null variants, are instead written in the
+// corresponding convention. Regardless of the field type, values are scanned
+// from any of the representations: TEXT in any of the layouts, REAL as a
+// Julian day number (with any fraction of a day ignored), and INTEGER as days
+// since 1970-01-01.
+//
+package gormdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/xtgo/epochdate"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+var (
+	errNull     = errors.New("gormdate: cannot scan NULL into Date; use NullDate")
+	errZeroDate = errors.New("gormdate: cannot scan MySQL zero date; use MySQLDate or NullMySQLDate")
+)
+
+// zeroPolicy specifies how MySQL's zero date is scanned. Zero dates are
+// received either as the text "0000-00-00", or, when the driver parses
+// times, as the zero time.Time.
+//
+type zeroPolicy int
+
+const (
+	zeroError  zeroPolicy = iota // fail to scan
+	zeroAsZero                   // scan as the zero Date, 1970-01-01
+	zeroAsNull                   // scan as NULL
+)
+
+// julianUnixEpoch is the Julian day number of 1970-01-01 at midnight UTC.
+const julianUnixEpoch = 2440587.5
+
+// Date is an epochdate.Date which may be used as a GORM model field.
+type Date struct {
+	epochdate.Date
+}
+
+// New returns d as a Date.
+func New(d epochdate.Date) Date {
+	return Date{d}
+}
+
+// Scan implements sql.Scanner.
+func (d *Date) Scan(src interface{}) error {
+	return d.scan(src, zeroError)
+}
+
+func (d *Date) scan(src interface{}, policy zeroPolicy) error {
+	v, null, err := scan(src, policy)
+	if null {
+		return errNull
+	}
+	if err != nil {
+		return err
+	}
+	d.Date = v
+	return nil
+}
+
+// Value implements driver.Valuer, returning midnight UTC on the date.
+func (d Date) Value() (driver.Value, error) {
+	return d.UTC(), nil
+}
+
+// GormDataType implements schema.GormDataTypeInterface.
+func (Date) GormDataType() string {
+	return dataType
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (Date) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return dbDataType(db)
+}
+
+// NullDate is an epochdate.Date which may be NULL, for use as a GORM model
+// field. It is analogous to sql.NullTime.
+//
+type NullDate struct {
+	Date  epochdate.Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NewNull returns d as a valid NullDate.
+func NewNull(d epochdate.Date) NullDate {
+	return NullDate{Date: d, Valid: true}
+}
+
+// Scan implements sql.Scanner.
+func (n *NullDate) Scan(src interface{}) error {
+	return n.scan(src, zeroError)
+}
+
+func (n *NullDate) scan(src interface{}, policy zeroPolicy) error {
+	v, null, err := scan(src, policy)
+	if null {
+		n.Date, n.Valid = 0, false
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	n.Date, n.Valid = v, true
+	return nil
+}
+
+// Value implements driver.Valuer, returning nil if n is not valid, and
+// otherwise the same value as Date.Value.
+//
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.UTC(), nil
+}
+
+// GormDataType implements schema.GormDataTypeInterface.
+func (NullDate) GormDataType() string {
+	return dataType
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (NullDate) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return dbDataType(db)
+}
+
+// MySQLDate is a Date which scans MySQL's zero date as the zero Date,
+// 1970-01-01, for use with legacy schemas which store zero dates in NOT NULL
+// columns.
+//
+type MySQLDate struct {
+	Date
+}
+
+// Scan implements sql.Scanner.
+func (d *MySQLDate) Scan(src interface{}) error {
+	return d.Date.scan(src, zeroAsZero)
+}
+
+// NullMySQLDate is a NullDate which scans MySQL's zero date as NULL, for use
+// with legacy schemas which store zero dates in place of NULL. Invalid
+// values are written as NULL rather than as zero dates.
+//
+type NullMySQLDate struct {
+	NullDate
+}
+
+// Scan implements sql.Scanner.
+func (n *NullMySQLDate) Scan(src interface{}) error {
+	return n.NullDate.scan(src, zeroAsNull)
+}
+
+// SQLiteText is a Date stored as ISO-8601 TEXT, such as "2006-01-02", which
+// the SQLite date functions accept directly.
+//
+type SQLiteText struct {
+	Date
+}
+
+// Value implements driver.Valuer.
+func (d SQLiteText) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (SQLiteText) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "TEXT"
+}
+
+// NullSQLiteText is a NullDate stored as ISO-8601 TEXT.
+type NullSQLiteText struct {
+	NullDate
+}
+
+// Value implements driver.Valuer.
+func (n NullSQLiteText) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.String(), nil
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (NullSQLiteText) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "TEXT"
+}
+
+// SQLiteJulianDay is a Date stored as a REAL Julian day number, at midnight
+// UTC, as returned by julianday('2006-01-02').
+//
+type SQLiteJulianDay struct {
+	Date
+}
+
+// Value implements driver.Valuer.
+func (d SQLiteJulianDay) Value() (driver.Value, error) {
+	return julianDay(d.Date.Date), nil
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (SQLiteJulianDay) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "REAL"
+}
+
+// NullSQLiteJulianDay is a NullDate stored as a REAL Julian day number.
+type NullSQLiteJulianDay struct {
+	NullDate
+}
+
+// Value implements driver.Valuer.
+func (n NullSQLiteJulianDay) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return julianDay(n.Date), nil
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (NullSQLiteJulianDay) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "REAL"
+}
+
+// SQLiteUnixDays is a Date stored as INTEGER days since 1970-01-01, which is
+// the representation of epochdate.Date.
+//
+type SQLiteUnixDays struct {
+	Date
+}
+
+// Value implements driver.Valuer.
+func (d SQLiteUnixDays) Value() (driver.Value, error) {
+	return int64(d.Date.Date), nil
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (SQLiteUnixDays) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "INTEGER"
+}
+
+// NullSQLiteUnixDays is a NullDate stored as INTEGER days since 1970-01-01.
+type NullSQLiteUnixDays struct {
+	NullDate
+}
+
+// Value implements driver.Valuer.
+func (n NullSQLiteUnixDays) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return int64(n.Date), nil
+}
+
+// GormDBDataType implements migrator.GormDataTypeInterface.
+func (NullSQLiteUnixDays) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return "INTEGER"
+}
+
+const dataType = "date"
+
+// dbDataType returns the column type used for dates by the dialect of db.
+func dbDataType(db *gorm.DB) string {
+	if db == nil || db.Dialector == nil {
+		return dataType
+	}
+	switch db.Dialector.Name() {
+	case "mysql", "sqlserver":
+		return "DATE"
+	}
+	return dataType
+}
+
+// julianDay returns the Julian day number of midnight UTC on d.
+func julianDay(d epochdate.Date) float64 {
+	return float64(d) + julianUnixEpoch
+}
+
+// scan converts a value returned by a driver to a Date, reporting whether
+// it is to be treated as NULL, and handling zero dates according to policy.
+// Times are converted using the date in their own location, since drivers
+// return DATE columns as midnight in either UTC or the connection's
+// location.
+//
+func scan(src interface{}, policy zeroPolicy) (d epochdate.Date, null bool, err error) {
+	var zero bool
+	switch v := src.(type) {
+	case nil:
+		return 0, true, nil
+
+	case time.Time:
+		zero = v.IsZero()
+		if !zero {
+			d, err = epochdate.NewFromTime(v)
+		}
+
+	case string:
+		zero = isZeroDate(v)
+		if !zero {
+			d, err = parse(v)
+		}
+
+	case []byte:
+		zero = isZeroDate(string(v))
+		if !zero {
+			d, err = parse(string(v))
+		}
+
+	case int64:
+		d, err = fromDays(float64(v))
+
+	case float64:
+		d, err = fromDays(math.Floor(v - julianUnixEpoch))
+
+	default:
+		return 0, false, fmt.Errorf("gormdate: cannot scan %T into Date", src)
+	}
+
+	if !zero {
+		return d, false, err
+	}
+	switch policy {
+	case zeroAsZero:
+		return 0, false, nil
+
+	case zeroAsNull:
+		return 0, true, nil
+	}
+	return 0, false, errZeroDate
+}
+
+// fromDays returns the Date which is the given number of days after
+// 1970-01-01.
+//
+func fromDays(days float64) (epochdate.Date, error) {
+	if days < 0 || days > float64(^epochdate.Date(0)) {
+		return 0, epochdate.ErrOutOfRange
+	}
+	return epochdate.Date(days), nil
+}
+
+// isZeroDate reports whether s is a MySQL zero date, which may have a zero
+// time component.
+//
+func isZeroDate(s string) bool {
+	return strings.HasPrefix(s, "0000-00-00") && strings.Trim(s[len("0000-00-00"):], " T0:.") == ""
+}
+
+// parse parses a DATE column in text form, which some drivers return with a
+// time component.
+//
+func parse(s string) (epochdate.Date, error) {
+	if len(s) > len(epochdate.RFC3339) {
+		switch s[len(epochdate.RFC3339)] {
+		case 'T', ' ':
+			s = s[:len(epochdate.RFC3339)]
+		}
+	}
+	return epochdate.ParseRFC(s)
+}
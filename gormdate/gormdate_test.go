@@ -0,0 +1,189 @@
+package gormdate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+	"gorm.io/gorm/migrator"
+	"gorm.io/gorm/schema"
+)
+
+func TestScan(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	tokyo := time.FixedZone("JST", 9*60*60)
+	tests := []struct {
+		name string
+		src  interface{}
+	}{
+		{"time", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"time_zoned", time.Date(2024, 3, 1, 0, 0, 0, 0, tokyo)},
+		{"string", "2024-03-01"},
+		{"string_time", "2024-03-01 00:00:00"},
+		{"bytes", []byte("2024-03-01T00:00:00Z")},
+		{"julian_day", 2460370.5},
+		{"julian_day_afternoon", 2460371.25},
+		{"unix_days", int64(19783)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Date
+			if err := d.Scan(tt.src); err != nil || d.Date != want {
+				t.Errorf("Date.Scan(%v) = %v, %v; want %v", tt.src, d, err, want)
+			}
+			var n NullDate
+			if err := n.Scan(tt.src); err != nil || n != NewNull(want) {
+				t.Errorf("NullDate.Scan(%v) = %+v, %v; want %v", tt.src, n, err, want)
+			}
+		})
+	}
+
+	var d Date
+	for _, src := range []interface{}{nil, 19783, "2024-03-01x", "1969-12-31"} {
+		if err := d.Scan(src); err == nil {
+			t.Errorf("Date.Scan(%#v) succeeded, want error", src)
+		}
+	}
+
+	n := NewNull(want)
+	if err := n.Scan(nil); err != nil || n.Valid || n.Date != 0 {
+		t.Errorf("NullDate.Scan(nil) = %+v, %v; want invalid", n, err)
+	}
+}
+
+func TestValue(t *testing.T) {
+	d := epochdate.MustParseRFC("2024-03-01")
+	want := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if v, err := New(d).Value(); err != nil || v != want {
+		t.Errorf("Date.Value() = %v, %v; want %v", v, err, want)
+	}
+	if v, err := NewNull(d).Value(); err != nil || v != want {
+		t.Errorf("NullDate.Value() = %v, %v; want %v", v, err, want)
+	}
+	if v, err := (NullDate{}).Value(); err != nil || v != nil {
+		t.Errorf("NullDate{}.Value() = %v, %v; want nil", v, err)
+	}
+}
+
+func TestSchema(t *testing.T) {
+	type invoice struct {
+		ID      uint
+		Issued  Date
+		Settled NullDate
+		Due     MySQLDate
+		Paid    NullMySQLDate
+		Sent    SQLiteUnixDays
+		Voided  NullSQLiteText
+	}
+
+	s, err := schema.Parse(&invoice{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"Issued", "Settled", "Due", "Paid", "Sent", "Voided"} {
+		f := s.LookUpField(name)
+		if f == nil {
+			t.Fatalf("missing field %s", name)
+		}
+		if f.DataType != "date" {
+			t.Errorf("%s.DataType = %q, want %q", name, f.DataType, "date")
+		}
+	}
+
+	if got := (Date{}).GormDBDataType(nil, nil); got != "date" {
+		t.Errorf("GormDBDataType(nil, nil) = %q, want %q", got, "date")
+	}
+}
+
+func TestZeroDates(t *testing.T) {
+	want := epochdate.MustParseRFC("2024-03-01")
+	for _, src := range []interface{}{"0000-00-00", []byte("0000-00-00 00:00:00"), time.Time{}} {
+		d := New(want)
+		if err := d.Scan(src); err == nil {
+			t.Errorf("Date.Scan(%#v) = %v; want error", src, d)
+		}
+		n := NewNull(want)
+		if err := n.Scan(src); err == nil {
+			t.Errorf("NullDate.Scan(%#v) = %+v; want error", src, n)
+		}
+
+		md := MySQLDate{New(want)}
+		if err := md.Scan(src); err != nil || md != (MySQLDate{}) {
+			t.Errorf("MySQLDate.Scan(%#v) = %v, %v; want zero", src, md, err)
+		}
+		mn := NullMySQLDate{NewNull(want)}
+		if err := mn.Scan(src); err != nil || mn != (NullMySQLDate{}) {
+			t.Errorf("NullMySQLDate.Scan(%#v) = %+v, %v; want invalid", src, mn, err)
+		}
+	}
+
+	md := MySQLDate{}
+	if err := md.Scan("0000-00-01"); err == nil {
+		t.Error("MySQLDate.Scan(0000-00-01) succeeded")
+	}
+	mn := NullMySQLDate{}
+	if err := mn.Scan("2024-03-01"); err != nil || mn != (NullMySQLDate{NewNull(want)}) {
+		t.Errorf("NullMySQLDate.Scan(2024-03-01) = %+v, %v; want %v", mn, err, want)
+	}
+	if err := mn.Scan(nil); err != nil || mn != (NullMySQLDate{}) {
+		t.Errorf("NullMySQLDate.Scan(nil) = %+v, %v; want invalid", mn, err)
+	}
+	if v, err := mn.Value(); err != nil || v != nil {
+		t.Errorf("NullMySQLDate{}.Value() = %v, %v; want nil", v, err)
+	}
+}
+
+func TestSQLiteStorage(t *testing.T) {
+	d := epochdate.MustParseRFC("2024-03-01")
+	tests := []struct {
+		name   string
+		valuer driver.Valuer
+		null   driver.Valuer
+		want   interface{}
+		dbType string
+	}{
+		{"time", New(d), NewNull(d), time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "date"},
+		{"text", SQLiteText{New(d)}, NullSQLiteText{NewNull(d)}, "2024-03-01", "TEXT"},
+		{"julian_day", SQLiteJulianDay{New(d)}, NullSQLiteJulianDay{NewNull(d)}, 2460370.5, "REAL"},
+		{"unix_days", SQLiteUnixDays{New(d)}, NullSQLiteUnixDays{NewNull(d)}, int64(19783), "INTEGER"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, valuer := range []driver.Valuer{tt.valuer, tt.null} {
+				v, err := valuer.Value()
+				if err != nil || v != tt.want {
+					t.Errorf("%T.Value() = %#v, %v; want %#v", valuer, v, err, tt.want)
+				}
+				if got := valuer.(migrator.GormDataTypeInterface).GormDBDataType(nil, nil); got != tt.dbType {
+					t.Errorf("%T.GormDBDataType(nil, nil) = %q, want %q", valuer, got, tt.dbType)
+				}
+
+				var got Date
+				if err := got.Scan(v); err != nil || got.Date != d {
+					t.Errorf("Scan(%#v) = %v, %v; want %v", v, got, err, d)
+				}
+			}
+
+			// The Null variants write invalid values as NULL.
+			null := reflect.New(reflect.TypeOf(tt.null)).Interface().(driver.Valuer)
+			if v, err := null.Value(); err != nil || v != nil {
+				t.Errorf("%T.Value() = %#v, %v; want nil", null, v, err)
+			}
+			if err := null.(sql.Scanner).Scan(tt.want); err != nil || reflect.ValueOf(null).Elem().Interface() != tt.null {
+				t.Errorf("%T.Scan(%#v) = %v, %v; want %v", null, tt.want, null, err, tt.null)
+			}
+		})
+	}
+
+	var got Date
+	for _, src := range []interface{}{int64(-1), int64(1 << 16), 2440586.5} {
+		if err := got.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) succeeded, want error", src)
+		}
+	}
+}
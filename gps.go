@@ -0,0 +1,72 @@
+package epochdate
+
+import (
+	"errors"
+	"time"
+)
+
+// gpsEpoch is the GPS epoch, Sunday 1980-01-06, as a Date.
+const gpsEpoch Date = 3657
+
+var (
+	errGPSEpoch    = errors.New("epochdate: dates before the GPS epoch, 1980-01-06, have no GPS week")
+	errGPSWeekBits = errors.New("epochdate: GPS week number bits must be in the range [1,30]")
+	errGPSWeek     = errors.New("epochdate: truncated GPS week number must be non-negative and fit in the given bits")
+	errGPSWeekday  = errors.New("epochdate: GPS weekday must be in the range [Sunday,Saturday]")
+)
+
+// GPSWeek returns the GPS week number of d, counting from the week beginning
+// on Sunday 1980-01-06, and the day within that week. The week number is not
+// truncated, unlike the 10-bit and 13-bit week numbers broadcast by
+// satellites. It returns an error if d precedes the GPS epoch.
+//
+// GPS time is ahead of UTC by the leap seconds since 1980, so an instant
+// within those seconds of midnight UTC belongs to the following GPS day;
+// dates are UTC dates.
+//
+func (d Date) GPSWeek() (week int, day time.Weekday, err error) {
+	if d < gpsEpoch {
+		return 0, 0, errGPSEpoch
+	}
+	n := int(d - gpsEpoch)
+	return n / 7, time.Weekday(n % 7), nil
+}
+
+// NewFromGPSWeek returns the date of the given day in a full GPS week
+// number. Out-of-range dates are clamped or yield ErrOutOfRange, according
+// to Clamp, but a weekday outside Sunday through Saturday is always an
+// error.
+//
+func NewFromGPSWeek(week int, weekday time.Weekday) (Date, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return 0, errGPSWeekday
+	}
+	return NewFromUnix(int64(int(gpsEpoch)+7*week+int(weekday)) * day)
+}
+
+// ResolveGPSWeek returns the full GPS week number corresponding to a week
+// number truncated to the given number of bits, such as the 10-bit week
+// numbers of legacy navigation messages, which rolled over in 1999 and 2019,
+// or the 13-bit week numbers of modern messages. As GPS receivers commonly
+// do, it chooses the earliest full week that is not before the week of
+// pivot, which would typically be the receiver's firmware build date. It
+// returns an error if week is negative, or does not fit in bits.
+//
+func ResolveGPSWeek(week, bits int, pivot Date) (int, error) {
+	if bits < 1 || bits > 30 {
+		return 0, errGPSWeekBits
+	}
+	mod := 1 << uint(bits)
+	if week < 0 || week >= mod {
+		return 0, errGPSWeek
+	}
+	base := 0
+	if pivot > gpsEpoch {
+		base = int(pivot-gpsEpoch) / 7
+	}
+	full := base - base%mod + week
+	if full < base {
+		full += mod
+	}
+	return full, nil
+}
@@ -0,0 +1,70 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGPSWeek(t *testing.T) {
+	tests := []struct {
+		date string
+		week int
+		day  time.Weekday
+	}{
+		{"1980-01-06", 0, time.Sunday},
+		{"1980-01-12", 0, time.Saturday},
+		{"1999-08-22", 1024, time.Sunday},
+		{"2019-04-07", 2048, time.Sunday},
+		{"2024-03-01", 2303, time.Friday},
+	}
+	for _, tt := range tests {
+		d := MustParseRFC(tt.date)
+		week, day, err := d.GPSWeek()
+		if err != nil || week != tt.week || day != tt.day {
+			t.Errorf("%s.GPSWeek() = %d, %v, %v; want %d, %v", tt.date, week, day, err, tt.week, tt.day)
+		}
+		if d.UTC().Weekday() != day {
+			t.Errorf("%s: day %v is not the weekday %v", tt.date, day, d.UTC().Weekday())
+		}
+		if d2, err := NewFromGPSWeek(week, day); err != nil || d2 != d {
+			t.Errorf("NewFromGPSWeek(%d, %v) = %v, %v; want %v", week, day, d2, err, d)
+		}
+	}
+	if _, _, err := MustParseRFC("1980-01-05").GPSWeek(); err == nil {
+		t.Error("GPSWeek() before the epoch succeeded")
+	}
+	for _, day := range []time.Weekday{-1, 7, 9} {
+		if d, err := NewFromGPSWeek(2303, day); err == nil {
+			t.Errorf("NewFromGPSWeek(2303, %d) = %v; want error", day, d)
+		}
+	}
+}
+
+func TestResolveGPSWeek(t *testing.T) {
+	tests := []struct {
+		week, bits int
+		pivot      string
+		want       int
+	}{
+		{255, 10, "2019-01-01", 2303},
+		{1000, 10, "2018-01-01", 2024},
+		{1000, 10, "2019-01-01", 3048},
+		{1000, 10, "1980-01-01", 1000},
+		{0, 10, "2019-04-07", 2048},
+		{2303, 13, "2019-01-01", 2303},
+	}
+	for _, tt := range tests {
+		got, err := ResolveGPSWeek(tt.week, tt.bits, MustParseRFC(tt.pivot))
+		if err != nil || got != tt.want {
+			t.Errorf("ResolveGPSWeek(%d, %d, %s) = %d, %v; want %d", tt.week, tt.bits, tt.pivot, got, err, tt.want)
+		}
+	}
+	if _, err := ResolveGPSWeek(1, 0, 0); err == nil {
+		t.Error("ResolveGPSWeek with 0 bits succeeded")
+	}
+	for _, week := range []int{-1, -1024, 1024} {
+		if got, err := ResolveGPSWeek(week, 10, MustParseRFC("2019-01-01")); err == nil {
+			t.Errorf("ResolveGPSWeek(%d, 10, 2019-01-01) = %d; want error", week, got)
+		}
+	}
+}
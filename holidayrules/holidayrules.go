@@ -0,0 +1,291 @@
+// Package holidayrules loads holiday calendars from rules held in JSON
+// configuration, so that operations staff can update calendars without
+// recompiling, whereas cmd/holidaygen compiles fixed lists of dates into
+// Go source. A configuration holds a calendar name, weekend days, and rules:
+//
+//	{
+//		"name": "US-FED",
+//		"weekend": ["sat", "sun"],
+//		"rules": [
+//			{"name": "New Year's Day", "month": 1, "day": 1, "observance": "nearest"},
+//			{"name": "Memorial Day", "month": 5, "weekday": "mon", "n": -1},
+//			{"name": "Thanksgiving", "month": 11, "weekday": "thu", "n": 4},
+//			{"name": "Good Friday", "easter": -2},
+//			{"name": "Juneteenth", "month": 6, "day": 19, "from": 2021, "observance": "nearest"},
+//			{"name": "National Day of Mourning", "date": "2025-01-09"}
+//		]
+//	}
+//
+// Each rule takes one of the following forms:
+//
+//	"date"                       a single date, in RFC 3339 form
+//	"month" and "day"            the same date every year
+//	"month", "weekday", and "n"  the nth weekday of the month, as computed
+//	                             by epochdate.NthWeekday; negative n counts
+//	                             from the end of the month
+//	"easter"                     an offset in days from Western (Gregorian)
+//	                             Easter Sunday
+//
+// Yearly rules are skipped in years without such a date, such as February
+// 29 in common years, or a fifth Monday in most months. They may be limited
+// to the years "from" through "through", inclusive, and may specify an
+// "observance", which moves holidays falling on weekends: "nearest" moves
+// Saturdays to the preceding Friday and Sundays to the following Monday,
+// "monday" moves both to the following Monday, and "sunday" moves only
+// Sundays to the following Monday. Finally, "substitute" moves holidays
+// falling on weekend days, or on the dates of holidays added by earlier
+// rules, to the next day which is neither, as for the substitute days of
+// the United Kingdom.
+//
+package holidayrules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// Config is a holiday calendar configuration.
+type Config struct {
+	Name    string   `json:"name"`
+	Weekend []string `json:"weekend"`
+	Rules   []Rule   `json:"rules"`
+}
+
+// Rule defines the dates of a holiday. See the package documentation for
+// the supported forms.
+//
+type Rule struct {
+	Name string `json:"name"`
+
+	Date    string `json:"date,omitempty"`
+	Month   int    `json:"month,omitempty"`
+	Day     int    `json:"day,omitempty"`
+	Weekday string `json:"weekday,omitempty"`
+	N       int    `json:"n,omitempty"`
+	Easter  *int   `json:"easter,omitempty"`
+
+	From       int    `json:"from,omitempty"`
+	Through    int    `json:"through,omitempty"`
+	Observance string `json:"observance,omitempty"`
+}
+
+// Load decodes a configuration from r, and returns the calendar of its
+// holidays in the years from through through, inclusive. Holidays which are
+// not representable as Dates are omitted. Unknown fields and invalid rules
+// are errors.
+//
+func Load(r io.Reader, from, through int) (epochdate.HolidayCalendar, error) {
+	var cfg Config
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return epochdate.HolidayCalendar{}, fmt.Errorf("holidayrules: %v", err)
+	}
+	return cfg.Calendar(from, through)
+}
+
+// LoadFile is like Load, except that it reads the configuration from the
+// named file.
+//
+func LoadFile(name string, from, through int) (epochdate.HolidayCalendar, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return epochdate.HolidayCalendar{}, err
+	}
+	defer f.Close()
+	return Load(f, from, through)
+}
+
+// Calendar returns the calendar of the configured holidays in the years
+// from through through, inclusive. A configuration without weekend days has
+// a Saturday and Sunday weekend.
+//
+func (cfg Config) Calendar(from, through int) (epochdate.HolidayCalendar, error) {
+	cal := epochdate.HolidayCalendar{
+		Name:     cfg.Name,
+		Weekend:  epochdate.SaturdaySunday,
+		Holidays: make(epochdate.DateSet),
+	}
+	if cfg.Weekend != nil {
+		cal.Weekend = nil
+		for _, s := range cfg.Weekend {
+			w, err := parseWeekday(s)
+			if err != nil {
+				return epochdate.HolidayCalendar{}, fmt.Errorf("holidayrules: weekend: %v", err)
+			}
+			cal.Weekend = append(cal.Weekend, w)
+		}
+	}
+	if err := cal.Validate(); err != nil {
+		return epochdate.HolidayCalendar{}, err
+	}
+	for i, r := range cfg.Rules {
+		if err := r.expand(cal, from, through); err != nil {
+			return epochdate.HolidayCalendar{}, fmt.Errorf("holidayrules: rule %d (%q): %v", i, r.Name, err)
+		}
+	}
+	return cal, nil
+}
+
+// expand adds the dates of r in the years from through through to the
+// holidays of cal.
+//
+func (r Rule) expand(cal epochdate.HolidayCalendar, from, through int) error {
+	set := cal.Holidays
+	if r.Date != "" {
+		if r.Month != 0 || r.Day != 0 || r.Weekday != "" || r.Easter != nil || r.From != 0 || r.Through != 0 || r.Observance != "" {
+			return errors.New("a rule with a date must not have other fields")
+		}
+		d, err := epochdate.ParseRFC(r.Date)
+		if err != nil {
+			return err
+		}
+		if year := d.UTC().Year(); year >= from && year <= through {
+			set.Add(d)
+		}
+		return nil
+	}
+
+	date, err := r.yearly()
+	if err != nil {
+		return err
+	}
+	substitute := r.Observance == "substitute"
+	observe := func(t time.Time) time.Time { return t }
+	if !substitute {
+		if observe, err = observance(r.Observance); err != nil {
+			return err
+		}
+	}
+	if r.From > from {
+		from = r.From
+	}
+	if r.Through != 0 && r.Through < through {
+		through = r.Through
+	}
+	for year := from; year <= through; year++ {
+		t, ok := date(year)
+		if !ok {
+			continue
+		}
+		t = observe(t)
+		if !epochdate.UnixInRange(t.Unix()) {
+			continue
+		}
+		d := epochdate.ClampFromTime(t)
+		for substitute && (cal.IsWeekend(d) || set.Contains(d)) && !d.IsMax() {
+			d++
+		}
+		set.Add(d)
+	}
+	return nil
+}
+
+// yearly returns a function computing the unobserved date of r in a year,
+// and whether there is one.
+//
+func (r Rule) yearly() (func(year int) (time.Time, bool), error) {
+	switch {
+	case r.Easter != nil:
+		if r.Month != 0 || r.Day != 0 || r.Weekday != "" || r.N != 0 {
+			return nil, errors.New("an Easter rule must not have a month, day, or weekday")
+		}
+		offset := *r.Easter
+		return func(year int) (time.Time, bool) {
+			return easter(year).AddDate(0, 0, offset), true
+		}, nil
+
+	case r.Month < 1 || r.Month > 12:
+		return nil, errors.New("month must be in range [1,12]")
+
+	case r.Weekday != "":
+		if r.Day != 0 {
+			return nil, errors.New("a weekday rule must not have a day")
+		}
+		w, err := parseWeekday(r.Weekday)
+		if err != nil {
+			return nil, err
+		}
+		if r.N == 0 || r.N < -5 || r.N > 5 {
+			return nil, errors.New("n must be in range [1,5] or [-5,-1]")
+		}
+		month, n := time.Month(r.Month), r.N
+		return func(year int) (time.Time, bool) {
+			d, err := epochdate.NthWeekday(year, month, w, n)
+			t := d.UTC()
+			// Check the date, in case it was clamped.
+			return t, err == nil && t.Year() == year && t.Month() == month
+		}, nil
+
+	case r.Day < 1 || r.Day > 31 || r.N != 0:
+		return nil, errors.New("a rule needs a date, a month and day, a month, weekday and n, or an Easter offset")
+	}
+	month, day := time.Month(r.Month), r.Day
+	return func(year int) (time.Time, bool) {
+		t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		return t, t.Day() == day
+	}, nil
+}
+
+// observance returns the function which moves holidays according to the
+// named observance rule.
+//
+func observance(name string) (func(time.Time) time.Time, error) {
+	var sat, sun int // days to move holidays on Saturday and Sunday
+	switch name {
+	case "":
+	case "nearest":
+		sat, sun = -1, 1
+	case "monday":
+		sat, sun = 2, 1
+	case "sunday":
+		sun = 1
+	default:
+		return nil, fmt.Errorf("unknown observance %q", name)
+	}
+	return func(t time.Time) time.Time {
+		switch t.Weekday() {
+		case time.Saturday:
+			return t.AddDate(0, 0, sat)
+		case time.Sunday:
+			return t.AddDate(0, 0, sun)
+		}
+		return t
+	}, nil
+}
+
+// easter returns the date of Western Easter Sunday in the given year, using
+// the anonymous Gregorian algorithm.
+//
+func easter(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	s = strings.TrimSpace(s)
+	for w := time.Sunday; w <= time.Saturday; w++ {
+		name := w.String()
+		if strings.EqualFold(s, name) || strings.EqualFold(s, name[:3]) {
+			return w, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown weekday %q", s)
+}
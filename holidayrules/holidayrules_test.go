@@ -0,0 +1,130 @@
+package holidayrules
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+const usFederal = `{
+	"name": "US-FED",
+	"rules": [
+		{"name": "New Year's Day", "month": 1, "day": 1, "observance": "nearest"},
+		{"name": "Memorial Day", "month": 5, "weekday": "mon", "n": -1},
+		{"name": "Juneteenth", "month": 6, "day": 19, "from": 2021, "observance": "nearest"},
+		{"name": "Independence Day", "month": 7, "day": 4, "observance": "nearest"},
+		{"name": "Thanksgiving", "month": 11, "weekday": "Thursday", "n": 4},
+		{"name": "Good Friday", "easter": -2},
+		{"name": "Leap Day", "month": 2, "day": 29},
+		{"name": "Mourning", "date": "2025-01-09"}
+	]
+}`
+
+func dates(cal epochdate.HolidayCalendar) []string {
+	var s []string
+	for _, d := range cal.Holidays.Sorted() {
+		s = append(s, d.String())
+	}
+	return s
+}
+
+func TestLoad(t *testing.T) {
+	cal, err := Load(strings.NewReader(usFederal), 2020, 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cal.Name != "US-FED" || !reflect.DeepEqual(cal.Weekend, epochdate.SaturdaySunday) {
+		t.Errorf("calendar = %q, %v", cal.Name, cal.Weekend)
+	}
+	want := []string{
+		"2020-01-01", "2020-02-29", "2020-04-10", "2020-05-25", "2020-07-03", "2020-11-26",
+		"2021-01-01", "2021-04-02", "2021-05-31", "2021-06-18", "2021-07-05", "2021-11-25",
+		"2021-12-31", // New Year's Day 2022, observed
+		"2022-04-15", "2022-05-30", "2022-06-20", "2022-07-04", "2022-11-24",
+	}
+	if got := dates(cal); !reflect.DeepEqual(got, want) {
+		t.Errorf("holidays = %v\nwant %v", got, want)
+	}
+}
+
+func TestEaster(t *testing.T) {
+	for year, want := range map[int]string{
+		1970: "1970-03-29", 2000: "2000-04-23", 2008: "2008-03-23",
+		2024: "2024-03-31", 2025: "2025-04-20", 2038: "2038-04-25",
+	} {
+		if got := easter(year).Format(epochdate.RFC3339); got != want {
+			t.Errorf("easter(%d) = %s; want %s", year, got, want)
+		}
+	}
+}
+
+func TestObservance(t *testing.T) {
+	sat := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	sun := sat.AddDate(0, 0, 1)
+	tests := []struct {
+		name     string
+		sat, sun int
+	}{
+		{"", 0, 0},
+		{"nearest", -1, 1},
+		{"monday", 2, 1},
+		{"sunday", 0, 1},
+	}
+	for _, tt := range tests {
+		fn, err := observance(tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fn(sat); !got.Equal(sat.AddDate(0, 0, tt.sat)) {
+			t.Errorf("%q observance of Saturday = %v", tt.name, got)
+		}
+		if got := fn(sun); !got.Equal(sun.AddDate(0, 0, tt.sun)) {
+			t.Errorf("%q observance of Sunday = %v", tt.name, got)
+		}
+	}
+}
+
+func TestLoad_errors(t *testing.T) {
+	for _, cfg := range []string{
+		`{"rules": [{"name": "x", "month": 13, "day": 1}]}`,
+		`{"rules": [{"name": "x", "month": 1}]}`,
+		`{"rules": [{"name": "x", "month": 1, "weekday": "mon"}]}`,
+		`{"rules": [{"name": "x", "month": 1, "weekday": "mon", "n": 6}]}`,
+		`{"rules": [{"name": "x", "month": 1, "weekday": "funday", "n": 1}]}`,
+		`{"rules": [{"name": "x", "month": 1, "day": 1, "weekday": "mon", "n": 1}]}`,
+		`{"rules": [{"name": "x", "easter": 1, "month": 1}]}`,
+		`{"rules": [{"name": "x", "date": "2024-01-01", "month": 1}]}`,
+		`{"rules": [{"name": "x", "date": "2024-13-01"}]}`,
+		`{"rules": [{"name": "x", "month": 1, "day": 1, "observance": "never"}]}`,
+		`{"rules": [{"name": "x", "month": 1, "day": 1, "color": "red"}]}`,
+		`{"weekend": ["sun", "mon", "tue", "wed", "thu", "fri", "sat"]}`,
+		`{"weekend": ["caturday"]}`,
+		`not json`,
+	} {
+		if _, err := Load(strings.NewReader(cfg), 2024, 2024); err == nil {
+			t.Errorf("Load(%s) succeeded", cfg)
+		}
+	}
+}
+
+func TestLoad_substitute(t *testing.T) {
+	const cfg = `{"rules": [
+		{"name": "Christmas Day", "month": 12, "day": 25, "observance": "substitute"},
+		{"name": "Boxing Day", "month": 12, "day": 26, "observance": "substitute"}
+	]}`
+	cal, err := Load(strings.NewReader(cfg), 2020, 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"2020-12-25", "2020-12-28", // Friday and Saturday
+		"2021-12-27", "2021-12-28", // Saturday and Sunday
+		"2022-12-26", "2022-12-27", // Sunday and Monday
+	}
+	if got := dates(cal); !reflect.DeepEqual(got, want) {
+		t.Errorf("holidays = %v; want %v", got, want)
+	}
+}
@@ -0,0 +1,73 @@
+package epochdate
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PeriodUnit identifies one of the fields of a Period.
+type PeriodUnit int
+
+// The units of a Period, in the order of its fields.
+const (
+	UnitYears PeriodUnit = iota
+	UnitMonths
+	UnitDays
+)
+
+// Language localizes the output of HumanizeBetween. Applications may
+// implement it to support languages other than English, or other styles,
+// such as "1y 2m".
+//
+type Language interface {
+	// Quantity returns a phrase for n of unit, such as "2 months".
+	Quantity(unit PeriodUnit, n int) string
+
+	// List joins one or more phrases, such as "1 year, 2 months".
+	List(phrases []string) string
+}
+
+// English is the English Language, producing text such as "1 year,
+// 2 months, 3 days".
+//
+var English Language = english{}
+
+type english struct{}
+
+func (english) Quantity(unit PeriodUnit, n int) string {
+	name := [...]string{UnitYears: "year", UnitMonths: "month", UnitDays: "day"}[unit]
+	if n != 1 {
+		name += "s"
+	}
+	return strconv.Itoa(n) + " " + name
+}
+
+func (english) List(phrases []string) string {
+	return strings.Join(phrases, ", ")
+}
+
+// HumanizeBetween describes the period between a and b, in either order, as
+// text such as "1 year, 2 months" for display in profiles or tenure
+// summaries. The period is calculated as by PeriodBetween, from the earlier
+// to the later date, and zero fields are omitted, so that equal dates are
+// described as zero days. If lang is nil, English is used.
+//
+func HumanizeBetween(a, b Date, lang Language) string {
+	if lang == nil {
+		lang = English
+	}
+	if b < a {
+		a, b = b, a
+	}
+	p := PeriodBetween(a, b)
+	if p.IsZero() {
+		return lang.List([]string{lang.Quantity(UnitDays, 0)})
+	}
+	var phrases []string
+	for unit, n := range [...]int{UnitYears: p.Years, UnitMonths: p.Months, UnitDays: p.Days} {
+		if n != 0 {
+			phrases = append(phrases, lang.Quantity(PeriodUnit(unit), n))
+		}
+	}
+	return lang.List(phrases)
+}
@@ -0,0 +1,43 @@
+package epochdate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestHumanizeBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"2024-01-01", "2024-01-01", "0 days"},
+		{"2024-01-01", "2024-01-02", "1 day"},
+		{"2023-01-15", "2024-03-15", "1 year, 2 months"},
+		{"2024-03-15", "2023-01-15", "1 year, 2 months"},
+		{"2020-02-29", "2024-03-31", "4 years, 1 month, 2 days"},
+		{"2024-01-01", "2024-01-22", "21 days"},
+	}
+	for _, tt := range tests {
+		if got := HumanizeBetween(MustParseRFC(tt.a), MustParseRFC(tt.b), nil); got != tt.want {
+			t.Errorf("HumanizeBetween(%s, %s) = %q; want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+type shortLanguage struct{}
+
+func (shortLanguage) Quantity(unit PeriodUnit, n int) string {
+	return fmt.Sprintf("%d%c", n, "ymd"[unit])
+}
+
+func (shortLanguage) List(phrases []string) string {
+	return strings.Join(phrases, " ")
+}
+
+func TestHumanizeBetween_language(t *testing.T) {
+	got := HumanizeBetween(MustParseRFC("2023-01-15"), MustParseRFC("2024-03-20"), shortLanguage{})
+	if want := "1y 2m 5d"; got != want {
+		t.Errorf("HumanizeBetween = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,92 @@
+package epochdate
+
+// Date and YearMonth are stored in schema-based binary formats, such as
+// FlatBuffers and Cap'n Proto, as integer fields. The compact convention is
+// a uint16 holding the value itself, which requires no conversion:
+//
+//	table Trade { settle:ushort; }          // FlatBuffers
+//	struct Trade { settle @0 :UInt16; }     # Cap'n Proto
+//
+//	b.PrependUint16Slot(0, uint16(settle), 0)
+//	settle := epochdate.Date(t.Settle())
+//
+// Since the zero value of such fields is both the default and 1970-01-01,
+// schemas that must distinguish an absent date should use a separate flag,
+// or the int32 convention with a sentinel outside the representable range,
+// such as -1. The int32 convention, days since 1970-01-01 (or months since
+// 1970-01 for YearMonth), is also shared with other systems, such as Arrow,
+// Avro, and Parquet, so it is preferable when data is exchanged with them.
+// The Int32 methods and NewFrom*Int32 functions convert to and from it.
+//
+//	table Trade { settle:int = -1; }
+//	struct Trade { settle @0 :Int32 = -1; }
+
+// Int32 returns d as a number of days since 1970-01-01.
+func (d Date) Int32() int32 {
+	return int32(d)
+}
+
+// NewFromInt32 returns the Date which is the given number of days after
+// 1970-01-01. Out-of-range values are clamped or yield ErrOutOfRange,
+// according to Clamp.
+//
+func NewFromInt32(days int32) (Date, error) {
+	switch {
+	case days >= 0 && days <= maxDate:
+		return Date(days), nil
+
+	case Clamp:
+		return ClampFromInt32(days), nil
+	}
+	return 0, ErrOutOfRange
+}
+
+// ClampFromInt32 behaves like NewFromInt32, except that it clamps
+// out-of-range values rather than returning an error.
+//
+func ClampFromInt32(days int32) Date {
+	switch {
+	case days < 0:
+		return 0
+
+	case days > maxDate:
+		return maxDate
+	}
+	return Date(days)
+}
+
+// Int32 returns ym as a number of months since 1970-01.
+func (ym YearMonth) Int32() int32 {
+	return int32(ym)
+}
+
+// NewYearMonthFromInt32 returns the YearMonth which is the given number of
+// months after 1970-01, or an error if it is out of range.
+//
+func NewYearMonthFromInt32(months int32) (YearMonth, error) {
+	if months < 0 || months > maxDate {
+		return 0, errYearMonthOutOfRange
+	}
+	return YearMonth(months), nil
+}
+
+// Ordinal returns the number of days from 1970-01-01 until d, which is the
+// underlying representation of d, in the range [0,65535]. It is suitable for
+// persisting d compactly, such as in caches and URLs, and is converted back
+// using FromOrdinal.
+//
+func (d Date) Ordinal() int {
+	return int(d)
+}
+
+// FromOrdinal returns the Date with the given ordinal, as returned by
+// Ordinal, or ErrOutOfRange if n is not in the range [0,65535]. Since an
+// ordinal identifies a date, rather than describing one, the result is
+// never clamped, regardless of Clamp.
+//
+func FromOrdinal(n int) (Date, error) {
+	if n < 0 || n > maxDate {
+		return 0, ErrOutOfRange
+	}
+	return Date(n), nil
+}
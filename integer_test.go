@@ -0,0 +1,89 @@
+package epochdate
+
+import (
+	"encoding/binary"
+	"testing"
+	"testing/quick"
+)
+
+// Both FlatBuffers and Cap'n Proto store scalars in little-endian order.
+var le = binary.LittleEndian
+
+func TestUint16RoundTrip(t *testing.T) {
+	f := func(d Date, ym YearMonth) bool {
+		b := make([]byte, 4)
+		le.PutUint16(b, uint16(d))
+		le.PutUint16(b[2:], uint16(ym))
+		return Date(le.Uint16(b)) == d && YearMonth(le.Uint16(b[2:])) == ym
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestInt32RoundTrip(t *testing.T) {
+	f := func(d Date, ym YearMonth) bool {
+		b := make([]byte, 8)
+		le.PutUint32(b, uint32(d.Int32()))
+		le.PutUint32(b[4:], uint32(ym.Int32()))
+		d2, err1 := NewFromInt32(int32(le.Uint32(b)))
+		ym2, err2 := NewYearMonthFromInt32(int32(le.Uint32(b[4:])))
+		return d2 == d && ym2 == ym && err1 == nil && err2 == nil
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewFromInt32(t *testing.T) {
+	defer func(c bool) { Clamp = c }(Clamp)
+
+	tests := []struct {
+		days    int32
+		clamped Date
+		ok      bool
+	}{
+		{0, 0, true},
+		{19783, 19783, true},
+		{maxDate, maxDate, true},
+		{-1, 0, false},
+		{maxDate + 1, maxDate, false},
+	}
+	for _, tt := range tests {
+		Clamp = false
+		d, err := NewFromInt32(tt.days)
+		if ok := err == nil; ok != tt.ok || (ok && d != tt.clamped) {
+			t.Errorf("NewFromInt32(%d) = %v, %v", tt.days, d, err)
+		}
+		Clamp = true
+		if d, err := NewFromInt32(tt.days); err != nil || d != tt.clamped {
+			t.Errorf("clamping NewFromInt32(%d) = %v, %v; want %v", tt.days, d, err, tt.clamped)
+		}
+		if d := ClampFromInt32(tt.days); d != tt.clamped {
+			t.Errorf("ClampFromInt32(%d) = %v, want %v", tt.days, d, tt.clamped)
+		}
+	}
+
+	for _, months := range []int32{-1, maxDate + 1} {
+		if _, err := NewYearMonthFromInt32(months); err == nil {
+			t.Errorf("NewYearMonthFromInt32(%d) succeeded", months)
+		}
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	defer func(c bool) { Clamp = c }(Clamp)
+	Clamp = true
+
+	for _, n := range []int{0, 19783, maxDate} {
+		d, err := FromOrdinal(n)
+		if err != nil || d.Ordinal() != n {
+			t.Errorf("FromOrdinal(%d) = %v, %v", n, d, err)
+		}
+	}
+	for _, n := range []int{-1, maxDate + 1} {
+		if d, err := FromOrdinal(n); err != ErrOutOfRange {
+			t.Errorf("FromOrdinal(%d) = %v, %v; want ErrOutOfRange", n, d, err)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package epochdate
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	errISOWeek    = errors.New("epochdate: ISO week number out of range for the year")
+	errISOWeekday = errors.New("epochdate: invalid weekday")
+)
+
+// ISOWeek returns the ISO 8601 year and week number in which d occurs. Week
+// ranges from 1 to 53. Jan 01 to Jan 03 of year n might belong to week 52 or
+// 53 of year n-1, and Dec 29 to Dec 31 might belong to week 1 of year n+1.
+//
+func (d Date) ISOWeek() (year, week int) {
+	// The ISO week belongs to the year of its Thursday, and is numbered by
+	// the Thursdays of that year.
+	thursday := int(d) - isoWeekdayIndex(d.Weekday()) + 3
+	year, yday := yearDay(thursday)
+	return year, (yday-1)/7 + 1
+}
+
+// FromISOWeek returns the date of the given weekday in an ISO 8601 week,
+// which begins on Monday. It returns an error unless week is in the range
+// [1,52], or 53 in years which have 53 ISO weeks. Out-of-range dates are
+// clamped or yield ErrOutOfRange, according to Clamp.
+//
+func FromISOWeek(isoYear, week int, weekday time.Weekday) (Date, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return 0, errISOWeekday
+	}
+	if week < 1 || week > isoWeeksIn(isoYear) {
+		return 0, errISOWeek
+	}
+	// Week 1 is the week containing January 4.
+	jan4 := time.Date(isoYear, time.January, 4, 0, 0, 0, 0, time.UTC)
+	monday := jan4.AddDate(0, 0, -isoWeekdayIndex(jan4.Weekday()))
+	t := monday.AddDate(0, 0, 7*(week-1)+isoWeekdayIndex(weekday))
+	return NewFromUnix(t.Unix())
+}
+
+// isoWeeksIn returns the number of ISO weeks in the given ISO year, which
+// is 53 if December 28, which is always in the last week, is in week 53.
+//
+func isoWeeksIn(isoYear int) int {
+	_, week := time.Date(isoYear, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// isoWeekdayIndex returns the number of days from Monday to wd.
+func isoWeekdayIndex(wd time.Weekday) int {
+	return (int(wd) + 6) % 7
+}
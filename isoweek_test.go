@@ -0,0 +1,57 @@
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+func TestFromISOWeek(t *testing.T) {
+	tests := []struct {
+		year, week int
+		weekday    time.Weekday
+		want       string
+	}{
+		{2024, 1, time.Monday, "2024-01-01"},
+		{2024, 9, time.Friday, "2024-03-01"},
+		{2021, 1, time.Monday, "2021-01-04"},
+		{2020, 53, time.Sunday, "2021-01-03"},
+		{2015, 53, time.Thursday, "2015-12-31"},
+		{2025, 1, time.Monday, "2024-12-30"},
+		{1970, 1, time.Thursday, "1970-01-01"},
+	}
+	for _, tt := range tests {
+		d, err := FromISOWeek(tt.year, tt.week, tt.weekday)
+		if err != nil || d.String() != tt.want {
+			t.Errorf("FromISOWeek(%d, %d, %v) = %v, %v; want %s", tt.year, tt.week, tt.weekday, d, err, tt.want)
+		}
+	}
+
+	errs := []struct {
+		year, week int
+		weekday    time.Weekday
+	}{
+		{2024, 0, time.Monday},
+		{2024, 53, time.Monday}, // 2024 has 52 weeks
+		{2020, 54, time.Monday},
+		{2024, 1, 7},
+		{2024, 1, -1},
+		{1970, 1, time.Monday}, // 1969-12-29
+	}
+	for _, tt := range errs {
+		if d, err := FromISOWeek(tt.year, tt.week, tt.weekday); err == nil {
+			t.Errorf("FromISOWeek(%d, %d, %v) = %v, want error", tt.year, tt.week, tt.weekday, d)
+		}
+	}
+}
+
+func TestISOWeekRoundTrip(t *testing.T) {
+	f := func(d Date) bool {
+		year, week := d.ISOWeek()
+		d2, err := FromISOWeek(year, week, d.UTC().Weekday())
+		return err == nil && d2 == d
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,65 @@
+//go:build go1.23
+// +build go1.23
+
+package epochdate
+
+import "iter"
+
+// DatesUntil returns an iterator over the dates from d up to, but not
+// including, end, so that it yields d.DaysUntil(end) dates, or none if end
+// is not after d:
+//
+//	for d := range start.DatesUntil(end) {
+//		...
+//	}
+//
+// (Date.DaysUntil already names the number of days from d until end.)
+//
+func (d Date) DatesUntil(end Date) iter.Seq[Date] {
+	if end <= d {
+		return emptyRange.All()
+	}
+	return DateRange{Start: d, End: end - 1}.All()
+}
+
+// All returns an iterator over the dates in r, in order. Unlike a loop over
+// Date values, it does not wrap around at the end of the representable
+// range.
+//
+func (r DateRange) All() iter.Seq[Date] {
+	return func(yield func(Date) bool) {
+		if r.Len() == 0 {
+			return
+		}
+		for n := int(r.Start); n <= int(r.End); n++ {
+			if !yield(Date(n)) {
+				return
+			}
+		}
+	}
+}
+
+// Dates returns an iterator over the dates in ym, in order. Dates beyond
+// the representable range are omitted, so that months after 2149-06 yield
+// none.
+//
+func (ym YearMonth) Dates() iter.Seq[Date] {
+	if ym.Validate() != nil {
+		return emptyRange.All()
+	}
+	return DateRange{Start: ym.StartDate(), End: ym.EndDate()}.All()
+}
+
+// Months returns an iterator over the months from ym up to, but not
+// including, end, so that it yields end.Sub(ym) months, or none if end is
+// not after ym.
+//
+func (ym YearMonth) Months(end YearMonth) iter.Seq[YearMonth] {
+	return func(yield func(YearMonth) bool) {
+		for n := int(ym); n < int(end); n++ {
+			if !yield(YearMonth(n)) {
+				return
+			}
+		}
+	}
+}
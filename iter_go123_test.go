@@ -0,0 +1,82 @@
+//go:build go1.23
+// +build go1.23
+
+package epochdate
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func collectSeq[T any](seq iter.Seq[T]) []T {
+	var values []T
+	for v := range seq {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestDate_DatesUntil(t *testing.T) {
+	d := MustParseRFC("2024-02-28")
+	got := collectSeq(d.DatesUntil(d + 3))
+	if want := []Date{d, d + 1, d + 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DatesUntil = %v; want %v", got, want)
+	}
+	for _, end := range []Date{d, d - 1, 0} {
+		if got := collectSeq(d.DatesUntil(end)); got != nil {
+			t.Errorf("DatesUntil(%v) = %v; want none", end, got)
+		}
+	}
+	if n := len(collectSeq(Date(0).DatesUntil(maxDate))); n != maxDate {
+		t.Errorf("DatesUntil(max) yielded %d dates; want %d", n, maxDate)
+	}
+}
+
+func TestDateRange_All(t *testing.T) {
+	got := collectSeq(DateRange{Start: maxDate - 1, End: maxDate}.All())
+	if want := []Date{maxDate - 1, maxDate}; !reflect.DeepEqual(got, want) {
+		t.Errorf("All = %v; want %v", got, want)
+	}
+	if got := collectSeq(emptyRange.All()); got != nil {
+		t.Errorf("empty All = %v; want none", got)
+	}
+
+	n := 0
+	for range RangeFrom(0).All() {
+		if n++; n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("All yielded %d dates after break; want 2", n)
+	}
+}
+
+func TestYearMonth_Dates(t *testing.T) {
+	got := collectSeq(ClampYearMonth(2024, time.February).Dates())
+	if len(got) != 29 || got[0].String() != "2024-02-01" || got[28].String() != "2024-02-29" {
+		t.Errorf("Dates of 2024-02 = %v", got)
+	}
+	if got := collectSeq(ClampYearMonth(2149, time.June).Dates()); len(got) != 6 {
+		t.Errorf("Dates of 2149-06 yielded %d dates; want 6", len(got))
+	}
+	if got := collectSeq(ClampYearMonth(2149, time.July).Dates()); got != nil {
+		t.Errorf("Dates of 2149-07 = %v; want none", got)
+	}
+}
+
+func TestYearMonth_Months(t *testing.T) {
+	start, end := ClampYearMonth(2024, time.November), ClampYearMonth(2025, time.February)
+	got := collectSeq(start.Months(end))
+	if want := []YearMonth{start, start + 1, start + 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Months = %v; want %v", got, want)
+	}
+	if len(got) != end.Sub(start) {
+		t.Errorf("Months yielded %d months; want %d", len(got), end.Sub(start))
+	}
+	if got := collectSeq(end.Months(start)); got != nil {
+		t.Errorf("reversed Months = %v; want none", got)
+	}
+}
@@ -0,0 +1,46 @@
+package epochdate
+
+import "time"
+
+// LeapDaysBetween returns the number of February 29ths from a until b,
+// including a but excluding b, consistent with a.DaysUntil(b). The result is
+// negative if b is before a.
+//
+func LeapDaysBetween(a, b Date) int {
+	return leapDaysBefore(b) - leapDaysBefore(a)
+}
+
+// LeapYearsBetween returns the number of leap years from the year of a
+// through the year of b, inclusive. The result is negative if b is before
+// a, so that swapping the arguments only changes its sign.
+//
+func LeapYearsBetween(a, b Date) int {
+	if b < a {
+		return -LeapYearsBetween(b, a)
+	}
+	return leapYearsBefore(b.UTC().Year()+1) - leapYearsBefore(a.UTC().Year())
+}
+
+// leapDaysBefore returns the number of February 29ths before d, back to
+// 1970-01-01.
+//
+func leapDaysBefore(d Date) int {
+	year, month, _ := d.Date()
+	n := leapYearsBefore(year) - leapYearsBefore(minYear)
+	if month > time.February && isLeapYear(year) {
+		n++
+	}
+	return n
+}
+
+// leapYearsBefore returns the number of leap years in the proleptic
+// Gregorian calendar from year 1 up to, but excluding, year.
+//
+func leapYearsBefore(year int) int {
+	y := year - 1
+	return y/4 - y/100 + y/400
+}
+
+func isLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
@@ -0,0 +1,58 @@
+package epochdate
+
+import "testing"
+
+func TestLeapDaysBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2024-01-01", "2024-02-29", 0},
+		{"2024-02-29", "2024-03-01", 1},
+		{"2024-03-01", "2024-02-29", -1},
+		{"2024-03-01", "2028-02-29", 0},
+		{"2024-03-01", "2028-03-01", 1},
+		{"1970-01-01", "2000-03-01", 8},
+		{"2096-01-01", "2104-03-01", 2},
+		{"1970-01-01", "2149-06-06", 44},
+	}
+	for _, tt := range tests {
+		if got := LeapDaysBetween(MustParseRFC(tt.a), MustParseRFC(tt.b)); got != tt.want {
+			t.Errorf("LeapDaysBetween(%s, %s) = %d; want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLeapYearsBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"2024-01-01", "2024-12-31", 1},
+		{"2023-01-01", "2023-12-31", 0},
+		{"2024-12-31", "2028-01-01", 2},
+		{"2028-01-01", "2024-12-31", -2},
+		{"2096-01-01", "2104-01-01", 2},
+		{"1970-01-01", "2149-06-06", 44},
+	}
+	for _, tt := range tests {
+		if got := LeapYearsBetween(MustParseRFC(tt.a), MustParseRFC(tt.b)); got != tt.want {
+			t.Errorf("LeapYearsBetween(%s, %s) = %d; want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLeapDaysBetween_exhaustive(t *testing.T) {
+	n := 0
+	for d := Date(0); ; d++ {
+		if got := LeapDaysBetween(0, d); got != n {
+			t.Fatalf("LeapDaysBetween(0, %v) = %d; want %d", d, got, n)
+		}
+		if _, m, dom := d.Date(); m == 2 && dom == 29 {
+			n++
+		}
+		if d.IsMax() {
+			break
+		}
+	}
+}
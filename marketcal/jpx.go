@@ -0,0 +1,150 @@
+package marketcal
+
+import (
+	"time"
+
+	"github.com/xtgo/epochdate"
+)
+
+// JPX is the Japan Exchange Group, including the Tokyo Stock Exchange,
+// which closes on the national holidays of Japan and from December 31
+// through January 3.
+//
+// Japanese holidays depend on the equinoxes, which are officially announced
+// a year in advance; they are computed here with the formulas customarily
+// used for forecasts, which are accurate for this century, but less certain
+// for the next.
+//
+var JPX = &Market{name: "JPX", build: buildJPX}
+
+// jpxHoliday is a yearly national holiday of Japan.
+type jpxHoliday struct {
+	from, through int // years in which the holiday applies; 0 is unbounded
+	date          func(year int) time.Time
+}
+
+// fixed returns a function for a holiday on the same date every year.
+func fixed(month time.Month, day int) func(int) time.Time {
+	return func(year int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+// monday returns a function for a holiday on the nth Monday of month.
+func monday(month time.Month, n int) func(int) time.Time {
+	return func(year int) time.Time {
+		d, _ := epochdate.NthWeekday(year, month, time.Monday, n)
+		return d.UTC()
+	}
+}
+
+// equinox returns a function for a holiday on the vernal (March) or
+// autumnal (September) equinox, in Japan Standard Time.
+//
+func equinox(month time.Month) func(int) time.Time {
+	return func(year int) time.Time {
+		c := 20.8431
+		switch {
+		case month == time.September && year >= 2100:
+			c = 24.2488
+		case month == time.September:
+			c = 23.2488
+		case year >= 2100:
+			c = 21.8510
+		}
+		n := year - 1980
+		day := int(c+0.242194*float64(n)) - n/4
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+}
+
+var jpxHolidays = []jpxHoliday{
+	{0, 0, fixed(time.January, 1)},       // New Year's Day
+	{0, 0, monday(time.January, 2)},      // Coming of Age Day
+	{0, 0, fixed(time.February, 11)},     // National Foundation Day
+	{2020, 0, fixed(time.February, 23)},  // Emperor's Birthday
+	{0, 0, equinox(time.March)},          // Vernal Equinox Day
+	{0, 0, fixed(time.April, 29)},        // Showa Day
+	{0, 0, fixed(time.May, 3)},           // Constitution Memorial Day
+	{0, 0, fixed(time.May, 4)},           // Greenery Day
+	{0, 0, fixed(time.May, 5)},           // Children's Day
+	{0, 2002, fixed(time.July, 20)},      // Marine Day
+	{2003, 2019, monday(time.July, 3)},   // Marine Day
+	{2022, 0, monday(time.July, 3)},      // Marine Day
+	{2016, 2019, fixed(time.August, 11)}, // Mountain Day
+	{2022, 0, fixed(time.August, 11)},    // Mountain Day
+	{0, 2002, fixed(time.September, 15)}, // Respect for the Aged Day
+	{2003, 0, monday(time.September, 3)}, // Respect for the Aged Day
+	{0, 0, equinox(time.September)},      // Autumnal Equinox Day
+	{0, 2019, monday(time.October, 2)},   // Sports Day
+	{2022, 0, monday(time.October, 2)},   // Sports Day
+	{0, 0, fixed(time.November, 3)},      // Culture Day
+	{0, 0, fixed(time.November, 23)},     // Labor Thanksgiving Day
+	{0, 2018, fixed(time.December, 23)},  // Emperor's Birthday
+}
+
+// jpxSpecial holds national holidays declared for single years.
+var jpxSpecial = []string{
+	"2019-04-30", "2019-05-01", "2019-05-02", "2019-10-22", // enthronement
+	"2020-07-23", "2020-07-24", "2020-08-10", // Olympic Games
+	"2021-07-22", "2021-07-23", "2021-08-08",
+}
+
+func buildJPX() epochdate.HolidayCalendar {
+	national := make(epochdate.DateSet)
+	for _, h := range jpxHolidays {
+		from, through := FirstYear, lastYear
+		if h.from > from {
+			from = h.from
+		}
+		if h.through != 0 && h.through < through {
+			through = h.through
+		}
+		for year := from; year <= through; year++ {
+			if d, err := epochdate.NewFromTime(h.date(year)); err == nil {
+				national.Add(d)
+			}
+		}
+	}
+	for _, s := range jpxSpecial {
+		national.Add(epochdate.MustParseRFC(s))
+	}
+
+	cal := epochdate.HolidayCalendar{
+		Name:     "JPX",
+		Weekend:  epochdate.SaturdaySunday,
+		Holidays: make(epochdate.DateSet, len(national)),
+	}
+	for _, d := range national.Sorted() {
+		cal.Holidays.Add(d)
+
+		// A holiday on a Sunday is substituted by the next day which is
+		// not a national holiday.
+		if d.UTC().Weekday() == time.Sunday {
+			s := d + 1
+			for national.Contains(s) && !s.IsMax() {
+				s++
+			}
+			cal.Holidays.Add(s)
+		}
+
+		// A day between two national holidays is a holiday.
+		if int(d)+2 <= int(^epochdate.Date(0)) && !national.Contains(d+1) && national.Contains(d+2) {
+			cal.Holidays.Add(d + 1)
+		}
+	}
+
+	// The exchange also closes from December 31 through January 3.
+	for year := FirstYear; year <= lastYear; year++ {
+		for _, t := range []time.Time{
+			time.Date(year, time.January, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(year, time.January, 3, 0, 0, 0, 0, time.UTC),
+			time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC),
+		} {
+			if d, err := epochdate.NewFromTime(t); err == nil {
+				cal.Holidays.Add(d)
+			}
+		}
+	}
+	return cal
+}
@@ -0,0 +1,107 @@
+// Package marketcal provides the trading calendars of financial markets,
+// for settlement and scheduling code which must skip exchange holidays:
+//
+//	settle, err := marketcal.NYSE.AddSessions(trade, 1)
+//
+// The calendars are computed from holiday rules, which are applied to the
+// years from 2000 through 2149, along with the special closures announced
+// since 2000. Dates before 2000 have no holidays, and closures announced in
+// future, such as for national mourning, are not known until this package
+// is updated. Early closes are not represented; such days are trading
+// days.
+//
+// Each Market implements epochdate.CalendarProvider, so that it may be used
+// wherever business days are counted, such as in an
+// epochdate.RenewalSchedule.
+//
+package marketcal
+
+import (
+	"sync"
+
+	"github.com/xtgo/epochdate"
+	"github.com/xtgo/epochdate/holidayrules"
+)
+
+// FirstYear is the first year for which holidays are known.
+const FirstYear = 2000
+
+// lastYear is the last year containing representable dates.
+const lastYear = 2149
+
+// Market is the trading calendar of a market. Its holidays are computed on
+// first use.
+//
+type Market struct {
+	name  string
+	build func() epochdate.HolidayCalendar
+
+	once sync.Once
+	cal  epochdate.HolidayCalendar
+}
+
+// newMarket returns a Market whose calendar is built from rules.
+func newMarket(cfg holidayrules.Config) *Market {
+	return &Market{name: cfg.Name, build: func() epochdate.HolidayCalendar {
+		cal, err := cfg.Calendar(FirstYear, lastYear)
+		if err != nil {
+			panic("marketcal: invalid rules for " + cfg.Name + ": " + err.Error())
+		}
+		return cal
+	}}
+}
+
+// Name returns the name of the market, such as "NYSE".
+func (m *Market) Name() string {
+	return m.name
+}
+
+// Calendar returns the holiday calendar of the market. The holidays must not
+// be modified.
+//
+func (m *Market) Calendar() epochdate.HolidayCalendar {
+	m.once.Do(func() { m.cal = m.build() })
+	return m.cal
+}
+
+// IsHoliday reports whether d is a holiday of the market.
+func (m *Market) IsHoliday(d epochdate.Date) bool {
+	return m.Calendar().IsHoliday(d)
+}
+
+// IsWeekend reports whether d falls on a weekend day of the market.
+func (m *Market) IsWeekend(d epochdate.Date) bool {
+	return m.Calendar().IsWeekend(d)
+}
+
+// IsTradingDay reports whether the market is open on d.
+func (m *Market) IsTradingDay(d epochdate.Date) bool {
+	return epochdate.IsBusinessDay(m, d)
+}
+
+// NextTradingDay returns the first trading day after d. ErrOutOfRange is
+// returned if there is no such representable date.
+//
+func (m *Market) NextTradingDay(d epochdate.Date) (epochdate.Date, error) {
+	return epochdate.NextBusinessDay(m, d)
+}
+
+// PreviousTradingDay returns the last trading day before d. ErrOutOfRange
+// is returned if there is no such representable date.
+//
+func (m *Market) PreviousTradingDay(d epochdate.Date) (epochdate.Date, error) {
+	return epochdate.AddBusinessDays(m, d, -1)
+}
+
+// AddSessions returns the date n trading sessions after d, or before d if n
+// is negative, as for epochdate.AddBusinessDays; for example, T+2
+// settlement of a trade on d is AddSessions(d, 2).
+//
+func (m *Market) AddSessions(d epochdate.Date, n int) (epochdate.Date, error) {
+	return epochdate.AddBusinessDays(m, d, n)
+}
+
+// Sessions returns the number of trading days in r.
+func (m *Market) Sessions(r epochdate.DateRange) int {
+	return epochdate.CountBusinessDays(m, r)
+}
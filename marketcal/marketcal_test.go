@@ -0,0 +1,81 @@
+package marketcal
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xtgo/epochdate"
+)
+
+// holidays returns the non-weekend holidays of m in year.
+func holidays(m *Market, year int) []string {
+	var s []string
+	start := epochdate.ClampFromDate(year, 1, 1)
+	end := epochdate.ClampFromDate(year, 12, 31)
+	for d := start; d <= end; d++ {
+		if !m.IsWeekend(d) && !m.IsTradingDay(d) {
+			s = append(s, d.Format("01-02"))
+		}
+		if d.IsMax() {
+			break
+		}
+	}
+	return s
+}
+
+func TestHolidays(t *testing.T) {
+	tests := []struct {
+		m    *Market
+		year int
+		want []string
+	}{
+		{NYSE, 2024, []string{"01-01", "01-15", "02-19", "03-29", "05-27", "06-19", "07-04", "09-02", "11-28", "12-25"}},
+		{NYSE, 2021, []string{"01-01", "01-18", "02-15", "04-02", "05-31", "07-05", "09-06", "11-25", "12-24"}},
+		{NYSE, 2022, []string{"01-17", "02-21", "04-15", "05-30", "06-20", "07-04", "09-05", "11-24", "12-26"}},
+		{NYSE, 2012, []string{"01-02", "01-16", "02-20", "04-06", "05-28", "07-04", "09-03", "10-29", "10-30", "11-22", "12-25"}},
+		{LSE, 2024, []string{"01-01", "03-29", "04-01", "05-06", "05-27", "08-26", "12-25", "12-26"}},
+		{LSE, 2022, []string{"01-03", "04-15", "04-18", "05-02", "06-02", "06-03", "08-29", "09-19", "12-26", "12-27"}},
+		{LSE, 2020, []string{"01-01", "04-10", "04-13", "05-08", "05-25", "08-31", "12-25", "12-28"}},
+		{TARGET2, 2024, []string{"01-01", "03-29", "04-01", "05-01", "12-25", "12-26"}},
+		{JPX, 2024, []string{"01-01", "01-02", "01-03", "01-08", "02-12", "02-23", "03-20", "04-29", "05-03", "05-06", "07-15", "08-12", "09-16", "09-23", "10-14", "11-04", "12-31"}},
+		{JPX, 2019, []string{"01-01", "01-02", "01-03", "01-14", "02-11", "03-21", "04-29", "04-30", "05-01", "05-02", "05-03", "05-06", "07-15", "08-12", "09-16", "09-23", "10-14", "10-22", "11-04", "12-31"}},
+		{JPX, 2026, []string{"01-01", "01-02", "01-12", "02-11", "02-23", "03-20", "04-29", "05-04", "05-05", "05-06", "07-20", "08-11", "09-21", "09-22", "09-23", "10-12", "11-03", "11-23", "12-31"}},
+	}
+	for _, tt := range tests {
+		if got := holidays(tt.m, tt.year); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %d holidays = %v\nwant %v", tt.m.Name(), tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestMarket(t *testing.T) {
+	thu := epochdate.MustParseRFC("2024-03-28") // before Good Friday
+	if d, err := NYSE.NextTradingDay(thu); err != nil || d.String() != "2024-04-01" {
+		t.Errorf("NextTradingDay(%v) = %v, %v", thu, d, err)
+	}
+	if d, err := LSE.AddSessions(thu, 2); err != nil || d.String() != "2024-04-03" {
+		t.Errorf("AddSessions(%v, 2) = %v, %v", thu, d, err)
+	}
+	if d, err := LSE.PreviousTradingDay(thu + 5); err != nil || d != thu {
+		t.Errorf("PreviousTradingDay = %v, %v; want %v", d, err, thu)
+	}
+	march := epochdate.DateRange{Start: epochdate.MustParseRFC("2024-03-01"), End: epochdate.MustParseRFC("2024-03-31")}
+	if n := NYSE.Sessions(march); n != 20 {
+		t.Errorf("NYSE sessions in March 2024 = %d; want 20", n)
+	}
+	if err := NYSE.Calendar().Validate(); err != nil || NYSE.Calendar().Name != "NYSE" {
+		t.Errorf("Calendar() = %v, %v", NYSE.Calendar().Name, err)
+	}
+
+	var _ epochdate.CalendarProvider = NYSE
+}
+
+func TestEquinox(t *testing.T) {
+	for year, want := range map[int][2]int{
+		2000: {20, 23}, 2012: {20, 22}, 2020: {20, 22}, 2024: {20, 22}, 2025: {20, 23}, 2044: {20, 22},
+	} {
+		if v, a := equinox(3)(year).Day(), equinox(9)(year).Day(); v != want[0] || a != want[1] {
+			t.Errorf("equinoxes of %d = %d, %d; want %d, %d", year, v, a, want[0], want[1])
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package marketcal
+
+import "github.com/xtgo/epochdate/holidayrules"
+
+// offset returns a pointer to n, for Easter offsets.
+func offset(n int) *int {
+	return &n
+}
+
+// NYSE is the New York Stock Exchange.
+var NYSE = newMarket(holidayrules.Config{
+	Name: "NYSE",
+	Rules: []holidayrules.Rule{
+		// New Year's Day is not observed on the preceding Friday.
+		{Name: "New Year's Day", Month: 1, Day: 1, Observance: "sunday"},
+		{Name: "Martin Luther King Jr. Day", Month: 1, Weekday: "mon", N: 3},
+		{Name: "Washington's Birthday", Month: 2, Weekday: "mon", N: 3},
+		{Name: "Good Friday", Easter: offset(-2)},
+		{Name: "Memorial Day", Month: 5, Weekday: "mon", N: -1},
+		{Name: "Juneteenth", Month: 6, Day: 19, From: 2022, Observance: "nearest"},
+		{Name: "Independence Day", Month: 7, Day: 4, Observance: "nearest"},
+		{Name: "Labor Day", Month: 9, Weekday: "mon", N: 1},
+		{Name: "Thanksgiving Day", Month: 11, Weekday: "thu", N: 4},
+		{Name: "Christmas Day", Month: 12, Day: 25, Observance: "nearest"},
+
+		{Name: "September 11 attacks", Date: "2001-09-11"},
+		{Name: "September 11 attacks", Date: "2001-09-12"},
+		{Name: "September 11 attacks", Date: "2001-09-13"},
+		{Name: "September 11 attacks", Date: "2001-09-14"},
+		{Name: "Ronald Reagan mourning", Date: "2004-06-11"},
+		{Name: "Gerald Ford mourning", Date: "2007-01-02"},
+		{Name: "Hurricane Sandy", Date: "2012-10-29"},
+		{Name: "Hurricane Sandy", Date: "2012-10-30"},
+		{Name: "George H. W. Bush mourning", Date: "2018-12-05"},
+		{Name: "Jimmy Carter mourning", Date: "2025-01-09"},
+	},
+})
+
+// LSE is the London Stock Exchange, which closes on the bank holidays of
+// England and Wales.
+//
+var LSE = newMarket(holidayrules.Config{
+	Name: "LSE",
+	Rules: []holidayrules.Rule{
+		{Name: "New Year's Day", Month: 1, Day: 1, Observance: "monday"},
+		{Name: "Good Friday", Easter: offset(-2)},
+		{Name: "Easter Monday", Easter: offset(1)},
+		{Name: "Early May bank holiday", Month: 5, Weekday: "mon", N: 1, Through: 2019},
+		{Name: "Early May bank holiday", Date: "2020-05-08"},
+		{Name: "Early May bank holiday", Month: 5, Weekday: "mon", N: 1, From: 2021},
+		{Name: "Spring bank holiday", Month: 5, Weekday: "mon", N: -1, Through: 2001},
+		{Name: "Spring bank holiday", Date: "2002-06-04"},
+		{Name: "Golden Jubilee", Date: "2002-06-03"},
+		{Name: "Spring bank holiday", Month: 5, Weekday: "mon", N: -1, From: 2003, Through: 2011},
+		{Name: "Spring bank holiday", Date: "2012-06-04"},
+		{Name: "Diamond Jubilee", Date: "2012-06-05"},
+		{Name: "Spring bank holiday", Month: 5, Weekday: "mon", N: -1, From: 2013, Through: 2021},
+		{Name: "Spring bank holiday", Date: "2022-06-02"},
+		{Name: "Platinum Jubilee", Date: "2022-06-03"},
+		{Name: "Spring bank holiday", Month: 5, Weekday: "mon", N: -1, From: 2023},
+		{Name: "Summer bank holiday", Month: 8, Weekday: "mon", N: -1},
+		{Name: "Christmas Day", Month: 12, Day: 25, Observance: "substitute"},
+		{Name: "Boxing Day", Month: 12, Day: 26, Observance: "substitute"},
+
+		{Name: "Royal wedding", Date: "2011-04-29"},
+		{Name: "State funeral of Queen Elizabeth II", Date: "2022-09-19"},
+		{Name: "Coronation of King Charles III", Date: "2023-05-08"},
+	},
+})
+
+// TARGET2 is the settlement calendar of the euro area's TARGET2 payment
+// system, which also determines euro money market business days. Its
+// holidays are not moved when they fall on weekends.
+//
+var TARGET2 = newMarket(holidayrules.Config{
+	Name: "TARGET2",
+	Rules: []holidayrules.Rule{
+		{Name: "New Year's Day", Month: 1, Day: 1},
+		{Name: "Good Friday", Easter: offset(-2)},
+		{Name: "Easter Monday", Easter: offset(1)},
+		{Name: "Labour Day", Month: 5, Day: 1},
+		{Name: "Christmas Day", Month: 12, Day: 25},
+		{Name: "Christmas Holiday", Month: 12, Day: 26},
+	},
+})
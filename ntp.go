@@ -0,0 +1,53 @@
+package epochdate
+
+// ntpEpochDays is the number of days from the NTP prime epoch, 1900-01-01,
+// to 1970-01-01.
+//
+const ntpEpochDays = 25567
+
+// NTPDay returns the number of days from the NTP prime epoch, 1900-01-01,
+// to d.
+//
+func (d Date) NTPDay() int {
+	return int(d) + ntpEpochDays
+}
+
+// NewFromNTPDay returns the Date which is the given number of days after the
+// NTP prime epoch, 1900-01-01. Out-of-range values are clamped or yield
+// ErrOutOfRange, according to Clamp.
+//
+func NewFromNTPDay(days int) (Date, error) {
+	return NewFromUnix(int64(days-ntpEpochDays) * day)
+}
+
+// NTPEra returns the NTP era number, and the offset in seconds within that
+// era, of midnight UTC on d, as defined by RFC 5905. Era 0 began at
+// 1900-01-01, and era 1 began on 2036-02-07, when the 32-bit seconds field of
+// NTP timestamps wrapped around.
+//
+func (d Date) NTPEra() (era int, offset uint32) {
+	s := int64(d.NTPDay()) * day
+	return int(s >> 32), uint32(s)
+}
+
+// NewFromNTPEra returns the UTC date of the instant at the given offset in
+// seconds within an NTP era. Out-of-range values are clamped or yield
+// ErrOutOfRange, according to Clamp.
+//
+func NewFromNTPEra(era int, offset uint32) (Date, error) {
+	return NewFromUnix(int64(era)<<32 + int64(offset) - ntpEpochDays*day)
+}
+
+// NewFromNTPSeconds returns the UTC date of a 32-bit NTP timestamp in
+// seconds, which does not identify its era. The era is chosen such that the
+// date is within 68 years of pivot, which would typically be the current
+// date or, for firmware, its build date, so that timestamps are correctly
+// interpreted across the rollover in 2036.
+//
+func NewFromNTPSeconds(seconds uint32, pivot Date) (Date, error) {
+	era, offset := pivot.NTPEra()
+	// Interpreting the difference as signed picks the nearest era.
+	delta := int64(int32(seconds - offset))
+	s := int64(era)<<32 + int64(offset) + delta
+	return NewFromUnix(s - ntpEpochDays*day)
+}
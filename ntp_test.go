@@ -0,0 +1,66 @@
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestNTPDay(t *testing.T) {
+	if n := Date(0).NTPDay(); n != 25567 {
+		t.Errorf("NTPDay() of 1970-01-01 = %d, want 25567", n)
+	}
+	f := func(d Date) bool {
+		d2, err := NewFromNTPDay(d.NTPDay())
+		return err == nil && d2 == d
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if _, err := NewFromNTPDay(25566); err == nil {
+		t.Error("NewFromNTPDay(25566) succeeded")
+	}
+}
+
+func TestNTPEra(t *testing.T) {
+	tests := []struct {
+		date   string
+		era    int
+		offset uint32
+	}{
+		{"1970-01-01", 0, 2208988800},
+		{"2036-02-07", 0, 4294944000},
+		{"2036-02-08", 1, 63104},
+	}
+	for _, tt := range tests {
+		d := MustParseRFC(tt.date)
+		era, offset := d.NTPEra()
+		if era != tt.era || offset != tt.offset {
+			t.Errorf("%s.NTPEra() = %d, %d; want %d, %d", tt.date, era, offset, tt.era, tt.offset)
+		}
+		if d2, err := NewFromNTPEra(era, offset+3600); err != nil || d2 != d {
+			t.Errorf("NewFromNTPEra(%d, %d) = %v, %v; want %v", era, offset+3600, d2, err, d)
+		}
+	}
+}
+
+func TestNewFromNTPSeconds(t *testing.T) {
+	// 2036-02-07 06:28:16 UTC is the first second of era 1, so its 32-bit
+	// timestamp is 0.
+	tests := []struct {
+		seconds uint32
+		pivot   string
+		want    string
+	}{
+		{0, "2024-01-01", "2036-02-07"},
+		{0, "1990-01-01", "2036-02-07"},
+		{4294880896, "2040-01-01", "2036-02-06"},
+		{2208988800, "2024-01-01", "1970-01-01"},
+		{2208988800, "2120-01-01", "2106-02-07"},
+	}
+	for _, tt := range tests {
+		d, err := NewFromNTPSeconds(tt.seconds, MustParseRFC(tt.pivot))
+		if err != nil || d.String() != tt.want {
+			t.Errorf("NewFromNTPSeconds(%d, %s) = %v, %v; want %s", tt.seconds, tt.pivot, d, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package epochdate
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullDate is a Date which may be absent, as in a nullable column or an
+// optional JSON field. It is analogous to sql.NullTime: the zero value is
+// absent, and is distinct from 1970-01-01.
+//
+//	type Account struct {
+//		Closed epochdate.NullDate `json:"closed"`
+//	}
+//
+type NullDate struct {
+	Date  Date
+	Valid bool // Valid is true if Date is present
+}
+
+// NewNullDate returns a valid NullDate holding d.
+func NewNullDate(d Date) NullDate {
+	return NullDate{Date: d, Valid: true}
+}
+
+// String returns the empty string if n is not valid, or else the same as
+// Date.String.
+//
+func (n NullDate) String() string {
+	if !n.Valid {
+		return ""
+	}
+	return n.Date.String()
+}
+
+// Scan implements sql.Scanner, accepting NULL as well as the values
+// accepted by Date.Scan.
+//
+func (n *NullDate) Scan(src interface{}) error {
+	if src == nil {
+		*n = NullDate{}
+		return nil
+	}
+	d, err := scanDate(src)
+	if err != nil {
+		return err
+	}
+	*n = NewNullDate(d)
+	return nil
+}
+
+// Value implements driver.Valuer, returning nil if n is not valid.
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Date.Value()
+}
+
+// MarshalText implements encoding.TextMarshaler, returning empty text if n
+// is not valid.
+//
+func (n NullDate) MarshalText() ([]byte, error) {
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting empty text
+// as an invalid NullDate.
+//
+func (n *NullDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*n = NullDate{}
+		return nil
+	}
+	var d Date
+	if err := d.UnmarshalText(data); err != nil {
+		return err
+	}
+	*n = NewNullDate(d)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, returning null if n is not valid.
+func (n NullDate) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Date.MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting null and the empty
+// string as an invalid NullDate.
+//
+func (n *NullDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*n = NullDate{}
+		return nil
+	}
+	data = bytes.Trim(data, `"`)
+	return n.UnmarshalText(data)
+}
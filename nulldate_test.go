@@ -0,0 +1,68 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullDate_JSON(t *testing.T) {
+	type account struct {
+		Opened NullDate `json:"opened"`
+		Closed NullDate `json:"closed"`
+	}
+	a := account{Opened: NewNullDate(0)}
+	data, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"opened":"1970-01-01","closed":null}`; string(data) != want {
+		t.Errorf("Marshal = %s; want %s", data, want)
+	}
+
+	var got account
+	if err := json.Unmarshal(data, &got); err != nil || got != a {
+		t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", data, got, err, a)
+	}
+	got = account{Opened: NewNullDate(5), Closed: NewNullDate(5)}
+	if err := json.Unmarshal([]byte(`{"opened":"","closed":null}`), &got); err != nil || got != (account{}) {
+		t.Errorf("Unmarshal of empty values = %+v, %v; want invalid", got, err)
+	}
+	if err := json.Unmarshal([]byte(`{"opened":"2024-02-30"}`), &got); err == nil {
+		t.Error("Unmarshal of invalid date succeeded")
+	}
+}
+
+func TestNullDate_Text(t *testing.T) {
+	for _, n := range []NullDate{{}, NewNullDate(0), NewNullDate(MustParseRFC("2024-03-01"))} {
+		text, err := n.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got NullDate
+		if err := got.UnmarshalText(text); err != nil || got != n {
+			t.Errorf("UnmarshalText(%q) = %+v, %v; want %+v", text, got, err, n)
+		}
+	}
+}
+
+func TestNullDate_SQL(t *testing.T) {
+	var n NullDate
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Errorf("Scan(nil) = %+v, %v; want invalid", n, err)
+	}
+	if v, err := n.Value(); v != nil || err != nil {
+		t.Errorf("invalid Value() = %v, %v; want nil", v, err)
+	}
+
+	midnight := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	if err := n.Scan(midnight); err != nil || n != NewNullDate(MustParseRFC("2024-03-01")) {
+		t.Errorf("Scan(%v) = %+v, %v", midnight, n, err)
+	}
+	if v, err := n.Value(); v != midnight || err != nil {
+		t.Errorf("Value() = %v, %v; want %v", v, err, midnight)
+	}
+	if err := n.Scan(true); err == nil {
+		t.Error("Scan(true) succeeded")
+	}
+}
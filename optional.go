@@ -0,0 +1,71 @@
+package epochdate
+
+import "bytes"
+
+// OptionalDate is a Date whose zero value, 1970-01-01, means "unset" in
+// encoded form: it is marshaled as JSON null, or as empty text, and the
+// empty string and null are unmarshaled as the zero value. Since the
+// underlying type is an integer, struct fields tagged with omitempty are
+// omitted entirely when zero:
+//
+//	type Account struct {
+//		Closed epochdate.OptionalDate `json:"closed,omitempty"`
+//	}
+//
+// Use NullDate when 1970-01-01 is a meaningful value which may also be
+// absent, or Date when the value is always present.
+//
+type OptionalDate Date
+
+// Date returns o as a Date.
+func (o OptionalDate) Date() Date {
+	return Date(o)
+}
+
+// String returns the empty string if o is zero, or else the same as
+// Date.String.
+//
+func (o OptionalDate) String() string {
+	if o == 0 {
+		return ""
+	}
+	return Date(o).String()
+}
+
+// MarshalText implements encoding.TextMarshaler, returning empty text if o
+// is zero.
+//
+func (o OptionalDate) MarshalText() ([]byte, error) {
+	return []byte(o.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting empty text
+// as the zero value.
+//
+func (o *OptionalDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*o = 0
+		return nil
+	}
+	return (*Date)(o).UnmarshalText(data)
+}
+
+// MarshalJSON implements json.Marshaler, returning null if o is zero.
+func (o OptionalDate) MarshalJSON() ([]byte, error) {
+	if o == 0 {
+		return []byte("null"), nil
+	}
+	return Date(o).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting null and the empty
+// string as the zero value.
+//
+func (o *OptionalDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		*o = 0
+		return nil
+	}
+	data = bytes.Trim(data, `"`)
+	return o.UnmarshalText(data)
+}
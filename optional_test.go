@@ -0,0 +1,53 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOptionalDate_JSON(t *testing.T) {
+	type record struct {
+		Opened OptionalDate `json:"opened"`
+		Closed OptionalDate `json:"closed,omitempty"`
+	}
+	tests := []struct {
+		r    record
+		want string
+	}{
+		{record{}, `{"opened":null}`},
+		{record{Opened: 19783}, `{"opened":"2024-03-01"}`},
+		{record{Opened: 1, Closed: 19783}, `{"opened":"1970-01-02","closed":"2024-03-01"}`},
+	}
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.r)
+		if err != nil || string(b) != tt.want {
+			t.Errorf("Marshal(%+v) = %s, %v; want %s", tt.r, b, err, tt.want)
+		}
+		var r record
+		if err := json.Unmarshal(b, &r); err != nil || r != tt.r {
+			t.Errorf("Unmarshal(%s) = %+v, %v; want %+v", b, r, err, tt.r)
+		}
+	}
+
+	r := record{Opened: 5, Closed: 5}
+	if err := json.Unmarshal([]byte(`{"opened":"","closed":null}`), &r); err != nil || r != (record{}) {
+		t.Errorf("Unmarshal of empty values = %+v, %v", r, err)
+	}
+	if err := json.Unmarshal([]byte(`{"opened":"soon"}`), &r); err == nil {
+		t.Error("Unmarshal of invalid date succeeded")
+	}
+}
+
+func TestOptionalDate_Text(t *testing.T) {
+	if s := OptionalDate(0).String(); s != "" {
+		t.Errorf("OptionalDate(0).String() = %q", s)
+	}
+	var o OptionalDate = 19783
+	b, _ := o.MarshalText()
+	if string(b) != "2024-03-01" || o.Date() != 19783 {
+		t.Errorf("MarshalText() = %s", b)
+	}
+	if err := o.UnmarshalText(nil); err != nil || o != 0 {
+		t.Errorf("UnmarshalText(empty) = %v, %v", o, err)
+	}
+}
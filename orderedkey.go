@@ -0,0 +1,63 @@
+package epochdate
+
+import "errors"
+
+var errShortKey = errors.New("epochdate: ordered key must be at least 2 bytes")
+
+// OrderedKey returns the receiver as a 2-byte big-endian key. Keys compare
+// bytewise (as with bytes.Compare) in the same order as the dates they
+// represent, so they are suitable for use as key prefixes in ordered
+// key-value stores such as bbolt, Badger, or LevelDB.
+//
+// Date deliberately does not implement encoding.BinaryMarshaler with this
+// encoding: gob prefers that interface, and would then be unable to decode
+// streams in which dates were encoded as integers.
+//
+func (d Date) OrderedKey() [2]byte {
+	return [2]byte{byte(d >> 8), byte(d)}
+}
+
+// AppendOrderedKey appends the OrderedKey encoding of the receiver to b and
+// returns the extended buffer.
+//
+func (d Date) AppendOrderedKey(b []byte) []byte {
+	return append(b, byte(d>>8), byte(d))
+}
+
+// NewFromOrderedKey decodes a Date from the first two bytes of key, which
+// must have been produced by OrderedKey or AppendOrderedKey. Any bytes after
+// the first two are ignored, allowing a Date to be read back from a key
+// prefix. An error is returned if key is shorter than two bytes.
+//
+func NewFromOrderedKey(key []byte) (Date, error) {
+	if len(key) < 2 {
+		return 0, errShortKey
+	}
+	return Date(key[0])<<8 | Date(key[1]), nil
+}
+
+// OrderedKey returns the receiver as a 2-byte big-endian key, which sorts
+// bytewise in month order, as with Date.OrderedKey.
+//
+func (ym YearMonth) OrderedKey() [2]byte {
+	return [2]byte{byte(ym >> 8), byte(ym)}
+}
+
+// AppendOrderedKey appends the OrderedKey encoding of the receiver to b and
+// returns the extended buffer.
+//
+func (ym YearMonth) AppendOrderedKey(b []byte) []byte {
+	return append(b, byte(ym>>8), byte(ym))
+}
+
+// NewYearMonthFromOrderedKey decodes a YearMonth from the first two bytes of
+// key, which must have been produced by YearMonth.OrderedKey or
+// YearMonth.AppendOrderedKey. Any bytes after the first two are ignored. An
+// error is returned if key is shorter than two bytes.
+//
+func NewYearMonthFromOrderedKey(key []byte) (YearMonth, error) {
+	if len(key) < 2 {
+		return 0, errShortKey
+	}
+	return YearMonth(key[0])<<8 | YearMonth(key[1]), nil
+}
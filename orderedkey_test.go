@@ -0,0 +1,122 @@
+package epochdate
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDate_OrderedKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Date
+		want  [2]byte
+	}{
+		{
+			name:  "zero",
+			input: 0,
+			want:  [2]byte{0x00, 0x00},
+		},
+		{
+			name:  "low_byte",
+			input: 0xff,
+			want:  [2]byte{0x00, 0xff},
+		},
+		{
+			name:  "high_byte",
+			input: 0x100,
+			want:  [2]byte{0x01, 0x00},
+		},
+		{
+			name:  "max",
+			input: maxDate,
+			want:  [2]byte{0xff, 0xff},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.OrderedKey()
+			if got != tt.want {
+				t.Errorf("%d.OrderedKey() = %x, want %x", tt.input, got, tt.want)
+			}
+
+			prefix := []byte("k/")
+			buf := tt.input.AppendOrderedKey(prefix)
+			if !bytes.Equal(buf, append(prefix, tt.want[:]...)) {
+				t.Errorf("%d.AppendOrderedKey(%q) = %x, want %x", tt.input, prefix, buf, tt.want)
+			}
+
+			date, err := NewFromOrderedKey(append(got[:], "suffix"...))
+			if err != nil {
+				t.Errorf("NewFromOrderedKey(%x) = %q [error], want nil", got, err)
+			} else if date != tt.input {
+				t.Errorf("NewFromOrderedKey(%x) = %d, want %d", got, date, tt.input)
+			}
+		})
+	}
+}
+
+func TestDate_OrderedKey_ordering(t *testing.T) {
+	for d := Date(1); d != 0; d++ {
+		prev, cur := (d - 1).OrderedKey(), d.OrderedKey()
+		if bytes.Compare(prev[:], cur[:]) >= 0 {
+			t.Fatalf("%d.OrderedKey() = %x does not sort before %d.OrderedKey() = %x", d-1, prev, d, cur)
+		}
+	}
+}
+
+func TestNewFromOrderedKey_short(t *testing.T) {
+	for _, key := range [][]byte{nil, {0x01}} {
+		_, err := NewFromOrderedKey(key)
+		if err == nil {
+			t.Errorf("NewFromOrderedKey(%x) = nil [error], want error", key)
+		}
+	}
+}
+
+func TestYearMonth_OrderedKey(t *testing.T) {
+	for _, ym := range []YearMonth{0, 0x0102, maxDate} {
+		key := ym.OrderedKey()
+		if key != [2]byte{byte(ym >> 8), byte(ym)} {
+			t.Errorf("%d.OrderedKey() = %x", ym, key)
+		}
+		prefix := []byte("k/")
+		if buf := ym.AppendOrderedKey(prefix); !bytes.Equal(buf, append(prefix, key[:]...)) {
+			t.Errorf("%d.AppendOrderedKey(%q) = %x, want %x", ym, prefix, buf, key)
+		}
+		if got, err := NewYearMonthFromOrderedKey(append(key[:], "suffix"...)); err != nil || got != ym {
+			t.Errorf("NewYearMonthFromOrderedKey(%x) = %d, %v; want %d", key, got, err, ym)
+		}
+	}
+	if _, err := NewYearMonthFromOrderedKey([]byte{1}); err == nil {
+		t.Error("NewYearMonthFromOrderedKey of 1 byte succeeded")
+	}
+}
+
+func TestDate_gob(t *testing.T) {
+	type record struct {
+		D  Date
+		YM YearMonth
+		DS []Date
+	}
+	want := record{D: MustParseRFC("2024-03-01"), YM: 650, DS: []Date{0, MustParseRFC("2024-03-01"), maxDate}}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+	var got record
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("gob round trip = %+v, %v; want %+v", got, err, want)
+	}
+
+	// blob was encoded by the original package, which encoded dates and
+	// months as gob integers.
+	const blob = "%\x7f\x03\x01\x01\x03rec\x01\xff\x80\x00\x01\x03\x01\x01D\x01\x06\x00\x01\x02YM\x01\x06\x00\x01\x02DS\x01\xff\x82\x00\x00\x00\x1e\xff\x81\x02\x01\x01\x10[]epochdate.Date\x01\xff\x82\x00\x01\x06\x00\x00\x14\xff\x80\x01\xfeMG\x01\xfe\x02\x8a\x01\x03\x00\xfeMG\xfe\xff\xff\x00"
+	got = record{}
+	if err := gob.NewDecoder(strings.NewReader(blob)).Decode(&got); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("gob decode of original stream = %+v, %v; want %+v", got, err, want)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/xtgo/epochdate/otelattr
+
+go 1.25.0
+
+require (
+	github.com/xtgo/epochdate v0.0.0
+	go.opentelemetry.io/otel v1.46.0
+)
+
+require github.com/cespare/xxhash/v2 v2.3.0 // indirect
+
+replace github.com/xtgo/epochdate => ../
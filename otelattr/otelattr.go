@@ -0,0 +1,41 @@
+// Package otelattr builds OpenTelemetry attributes from epochdate values,
+// so that traces, metrics, and logs from different services tag business
+// dates consistently. Dates are recorded as RFC 3339 strings, such as
+// "2024-03-01", which are readable and sort correctly; the Days variants
+// record the number of days since 1970-01-01 instead, for backends that
+// aggregate or compare numeric attributes.
+//
+package otelattr
+
+import (
+	"github.com/xtgo/epochdate"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Date returns an attribute holding d as an RFC 3339 date string.
+func Date(key string, d epochdate.Date) attribute.KeyValue {
+	return attribute.String(key, d.String())
+}
+
+// Days returns an attribute holding d as the number of days since
+// 1970-01-01.
+//
+func Days(key string, d epochdate.Date) attribute.KeyValue {
+	return attribute.Int(key, int(d))
+}
+
+// YearMonth returns an attribute holding ym as a year-month string, such as
+// "2024-03".
+//
+func YearMonth(key string, ym epochdate.YearMonth) attribute.KeyValue {
+	return attribute.String(key, ym.String())
+}
+
+// Dates returns an attribute holding ds as a slice of RFC 3339 date strings.
+func Dates(key string, ds []epochdate.Date) attribute.KeyValue {
+	s := make([]string, len(ds))
+	for i, d := range ds {
+		s[i] = d.String()
+	}
+	return attribute.StringSlice(key, s)
+}
@@ -0,0 +1,28 @@
+package otelattr
+
+import (
+	"testing"
+
+	"github.com/xtgo/epochdate"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestAttributes(t *testing.T) {
+	d := epochdate.MustParseRFC("2024-03-01")
+	tests := []struct {
+		kv   attribute.KeyValue
+		want attribute.KeyValue
+	}{
+		{Date("order.date", d), attribute.String("order.date", "2024-03-01")},
+		{Days("order.day", d), attribute.Int("order.day", 19783)},
+		{YearMonth("order.month", d.YearMonth()), attribute.String("order.month", "2024-03")},
+		{Dates("order.dates", []epochdate.Date{d, d + 1}), attribute.StringSlice("order.dates", []string{"2024-03-01", "2024-03-02"})},
+	}
+	for _, tt := range tests {
+		if tt.kv.Key != tt.want.Key || tt.kv.Value.Emit() != tt.want.Value.Emit() || tt.kv.Value.Type() != tt.want.Value.Type() {
+			t.Errorf("attribute = %s=%s (%v), want %s=%s (%v)",
+				tt.kv.Key, tt.kv.Value.Emit(), tt.kv.Value.Type(),
+				tt.want.Key, tt.want.Value.Emit(), tt.want.Value.Type())
+		}
+	}
+}
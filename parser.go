@@ -0,0 +1,93 @@
+package epochdate
+
+import "time"
+
+// Parser parses and converts dates according to its own configuration,
+// rather than the package-level Clamp variable, so that it is safe for
+// concurrent use, and different parts of a program may be configured
+// differently:
+//
+//	var parser = epochdate.Parser{
+//		Clamp:   true,
+//		Layouts: []string{epochdate.RFC3339, "02/01/2006"},
+//	}
+//
+// The zero Parser behaves as the package-level functions do when Clamp is
+// false.
+//
+type Parser struct {
+	// Clamp uses the nearest representable date if the input is out of
+	// range. When false, ErrOutOfRange is returned instead.
+	Clamp bool
+
+	// Layouts are the layouts accepted by Parse, in the form used by
+	// time.Parse, which are tried in order. If empty, only RFC3339 is
+	// accepted.
+	Layouts []string
+
+	// Location is the location in which Parse interprets values without
+	// zone information, and in which NewFromTime and NewFromUnix take the
+	// dates of instants. If nil, values without zone information are UTC,
+	// NewFromTime takes dates in the location of each time, and NewFromUnix
+	// takes dates in UTC, as the package-level functions do.
+	Location *time.Location
+}
+
+// Parse parses value according to the first of p.Layouts that accepts it,
+// ignoring time-of-day information. If no layout accepts value, the error
+// is that of the first layout.
+//
+func (p Parser) Parse(value string) (Date, error) {
+	layouts := p.Layouts
+	if len(layouts) == 0 {
+		layouts = []string{RFC3339}
+	}
+	var first error
+	for _, layout := range layouts {
+		if layout == RFC3339 {
+			if days, ok := parseRFC(value); ok {
+				return newFromUnix(int64(days)*day, p.Clamp)
+			}
+		}
+		loc := p.Location
+		if loc == nil {
+			loc = time.UTC
+		}
+		t, err := time.ParseInLocation(layout, value, loc)
+		if err == nil {
+			return p.NewFromTime(t)
+		}
+		if first == nil {
+			first = err
+		}
+	}
+	return 0, first
+}
+
+// NewFromTime returns the date of t in p.Location, or in the location of t
+// if p.Location is nil.
+//
+func (p Parser) NewFromTime(t time.Time) (Date, error) {
+	if p.Location != nil {
+		t = t.In(p.Location)
+	}
+	_, offset := t.Zone()
+	return newFromUnix(t.Unix()+int64(offset), p.Clamp)
+}
+
+// NewFromUnix returns the date in p.Location, or in UTC if p.Location is
+// nil, of the instant given as a Unix timestamp.
+//
+func (p Parser) NewFromUnix(seconds int64) (Date, error) {
+	if p.Location != nil {
+		return p.NewFromTime(time.Unix(seconds, 0))
+	}
+	return newFromUnix(seconds, p.Clamp)
+}
+
+// NewFromDate returns the Date with the given year, month, and day, which
+// are normalized as by time.Date.
+//
+func (p Parser) NewFromDate(year int, month time.Month, day int) (Date, error) {
+	return newFromUnix(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix(), p.Clamp)
+}
@@ -0,0 +1,81 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParser_Parse(t *testing.T) {
+	p := Parser{Layouts: []string{RFC3339, "02/01/2006", time.RFC3339}}
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"2024-03-01", "2024-03-01"},
+		{"01/03/2024", "2024-03-01"},
+		{"2024-03-01T23:30:00-05:00", "2024-03-01"},
+	}
+	for _, test := range tests {
+		if d, err := p.Parse(test.value); err != nil || d.String() != test.want {
+			t.Errorf("Parse(%q) = %v, %v; want %s", test.value, d, err, test.want)
+		}
+	}
+
+	_, want := time.Parse(RFC3339, "March 1")
+	if _, err := p.Parse("March 1"); err == nil || err.Error() != want.Error() {
+		t.Errorf("Parse(March 1) error = %v; want %v", err, want)
+	}
+	if _, err := (Parser{}).Parse("01/03/2024"); err == nil {
+		t.Error("zero Parser accepted a layout other than RFC3339")
+	}
+}
+
+func TestParser_Clamp(t *testing.T) {
+	strict, clamping := Parser{}, Parser{Clamp: true}
+	for _, s := range []string{"1969-12-31", "2149-06-07"} {
+		if _, err := strict.Parse(s); err != ErrOutOfRange {
+			t.Errorf("strict Parse(%q) error = %v; want ErrOutOfRange", s, err)
+		}
+	}
+	if d, err := clamping.Parse("1969-12-31"); err != nil || d != 0 {
+		t.Errorf("clamping Parse(1969-12-31) = %d, %v; want 0", d, err)
+	}
+	if d, err := clamping.NewFromUnix(-1); err != nil || d != 0 {
+		t.Errorf("clamping NewFromUnix(-1) = %d, %v; want 0", d, err)
+	}
+	if d, err := clamping.NewFromDate(2200, time.January, 1); err != nil || d != maxDate {
+		t.Errorf("clamping NewFromDate(2200-01-01) = %d, %v; want max", d, err)
+	}
+	if _, err := strict.NewFromDate(2200, time.January, 1); err != ErrOutOfRange {
+		t.Errorf("strict NewFromDate(2200-01-01) error = %v; want ErrOutOfRange", err)
+	}
+
+	// The package-level variable does not affect Parsers.
+	defer func(clamp bool) { Clamp = clamp }(Clamp)
+	Clamp = true
+	if _, err := strict.Parse("1969-12-31"); err != ErrOutOfRange {
+		t.Errorf("strict Parse with Clamp set: error = %v; want ErrOutOfRange", err)
+	}
+}
+
+func TestParser_Location(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	p := Parser{Location: tokyo, Layouts: []string{"2006-01-02 15:04", time.RFC3339}}
+
+	instant := time.Date(2024, time.February, 29, 20, 0, 0, 0, time.UTC)
+	if d, err := p.NewFromTime(instant); err != nil || d.String() != "2024-03-01" {
+		t.Errorf("NewFromTime(%v) = %v, %v; want 2024-03-01", instant, d, err)
+	}
+	if d, err := p.NewFromUnix(instant.Unix()); err != nil || d.String() != "2024-03-01" {
+		t.Errorf("NewFromUnix(%d) = %v, %v; want 2024-03-01", instant.Unix(), d, err)
+	}
+	if d, err := (Parser{}).NewFromUnix(instant.Unix()); err != nil || d.String() != "2024-02-29" {
+		t.Errorf("zero Parser NewFromUnix(%d) = %v, %v; want 2024-02-29", instant.Unix(), d, err)
+	}
+	if d, err := p.Parse("2024-02-29 20:00"); err != nil || d.String() != "2024-02-29" {
+		t.Errorf("Parse of local time = %v, %v; want 2024-02-29", d, err)
+	}
+	if d, err := p.Parse("2024-02-29T20:00:00Z"); err != nil || d.String() != "2024-03-01" {
+		t.Errorf("Parse of UTC time = %v, %v; want 2024-03-01", d, err)
+	}
+}
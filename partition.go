@@ -0,0 +1,89 @@
+package epochdate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PartitionStyle selects the layout of partition paths, as used by data
+// lakes and object stores to organize data by date.
+//
+type PartitionStyle int
+
+const (
+	// PartitionHive is the key=value layout used by Hive, Spark, and
+	// others, as in "year=2024/month=03/day=01".
+	PartitionHive PartitionStyle = iota
+
+	// PartitionPlain is the bare layout, as in "2024/03/01".
+	PartitionPlain
+)
+
+// PartitionPath returns the partition path of d in the given style, without
+// leading or trailing slashes.
+//
+func (d Date) PartitionPath(style PartitionStyle) string {
+	if style == PartitionPlain {
+		return d.Format("2006/01/02")
+	}
+	return d.Format("year=2006/month=01/day=02")
+}
+
+// ParsePartitionPath returns the date of a partition path in the given
+// style. For PartitionHive, the path may contain other segments, such as a
+// table prefix or file name, as in
+// "warehouse/events/year=2024/month=03/day=01/part-0.parquet", and the
+// year, month, and day segments are found by their keys. For
+// PartitionPlain, the path must consist of exactly the year, month, and day
+// segments, apart from leading and trailing slashes.
+//
+func ParsePartitionPath(path string, style PartitionStyle) (Date, error) {
+	errSyntax := fmt.Errorf("epochdate: invalid partition path %q", path)
+	var parts [3]string
+	if style == PartitionPlain {
+		segs := strings.Split(strings.Trim(path, "/"), "/")
+		if len(segs) != len(parts) {
+			return 0, errSyntax
+		}
+		copy(parts[:], segs)
+	} else {
+		keys := [...]string{"year=", "month=", "day="}
+		for _, seg := range strings.Split(path, "/") {
+			for i, key := range keys {
+				if strings.HasPrefix(seg, key) {
+					if parts[i] != "" {
+						return 0, errSyntax
+					}
+					parts[i] = seg[len(key):]
+				}
+			}
+		}
+	}
+
+	var n [3]int
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || p == "" || !isDigit(p[0]) {
+			return 0, errSyntax
+		}
+		n[i] = v
+	}
+	if n[1] < 1 || n[1] > 12 || n[2] < 1 || n[2] > daysIn(n[0], time.Month(n[1])) {
+		return 0, errSyntax
+	}
+	return NewFromDate(n[0], time.Month(n[1]), n[2])
+}
+
+// Bucket returns the shard, in the range [0,n), to which d is assigned when
+// dates are distributed round-robin among n shards, so that consecutive
+// dates fall in different shards. The assignment is stable, depending only
+// on d and n. It panics if n is not positive.
+//
+func (d Date) Bucket(n int) int {
+	if n <= 0 {
+		panic("epochdate: Bucket called with non-positive n")
+	}
+	return int(d) % n
+}
@@ -0,0 +1,61 @@
+package epochdate
+
+import "testing"
+
+func TestPartitionPath(t *testing.T) {
+	d := MustParseRFC("2024-03-01")
+	if got := d.PartitionPath(PartitionHive); got != "year=2024/month=03/day=01" {
+		t.Errorf("PartitionPath(PartitionHive) = %q", got)
+	}
+	if got := d.PartitionPath(PartitionPlain); got != "2024/03/01" {
+		t.Errorf("PartitionPath(PartitionPlain) = %q", got)
+	}
+
+	tests := []struct {
+		path  string
+		style PartitionStyle
+	}{
+		{"year=2024/month=03/day=01", PartitionHive},
+		{"s3://lake/events/year=2024/month=3/day=1/part-0.parquet", PartitionHive},
+		{"day=01/month=03/year=2024", PartitionHive},
+		{"2024/03/01", PartitionPlain},
+		{"/2024/3/1/", PartitionPlain},
+	}
+	for _, tt := range tests {
+		if got, err := ParsePartitionPath(tt.path, tt.style); err != nil || got != d {
+			t.Errorf("ParsePartitionPath(%q) = %v, %v; want %v", tt.path, got, err, d)
+		}
+	}
+
+	for _, tt := range []struct {
+		path  string
+		style PartitionStyle
+	}{
+		{"year=2024/month=03", PartitionHive},
+		{"year=2024/month=02/day=30", PartitionHive},
+		{"year=2024/year=2025/month=02/day=01", PartitionHive},
+		{"year=2024/month=+2/day=01", PartitionHive},
+		{"events/2024/03/01", PartitionPlain},
+		{"2024/13/01", PartitionPlain},
+		{"2150/01/01", PartitionPlain},
+	} {
+		if got, err := ParsePartitionPath(tt.path, tt.style); err == nil {
+			t.Errorf("ParsePartitionPath(%q) = %v; want error", tt.path, got)
+		}
+	}
+}
+
+func TestDate_Bucket(t *testing.T) {
+	counts := make([]int, 7)
+	for d := Date(0); d < 700; d++ {
+		counts[d.Bucket(7)]++
+	}
+	for i, n := range counts {
+		if n != 100 {
+			t.Errorf("bucket %d has %d dates; want 100", i, n)
+		}
+	}
+	if b := Date(maxDate).Bucket(1); b != 0 {
+		t.Errorf("Bucket(1) = %d", b)
+	}
+}
@@ -0,0 +1,220 @@
+package epochdate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is an amount of calendar time in years, months, and days, such as
+// a subscription term or notice period. Unlike a time.Duration, the number
+// of days spanned by a Period depends on the date it is applied to.
+//
+// The fields may have any sign, and are not normalized, so that a Period of
+// 14 months is distinct from one of 1 year and 2 months.
+//
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+var (
+	errPeriodField = errors.New("epochdate: Period fields must be in the range of a 32-bit integer")
+	errPeriodSpan  = errors.New("epochdate: Period exceeds the span of the representable range")
+)
+
+// IsZero reports whether p is the zero period.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// String returns p in ISO 8601 duration syntax, such as "P1Y2M10D". Zero
+// fields are omitted, and the zero Period is "P0D". A Period of only days,
+// in a whole number of weeks, uses the weeks form, such as "P2W". Negative
+// fields are written with a minus sign, as in "P-1M", or the whole period is
+// negated, as in "-P1Y2M", if every field is negative or zero.
+//
+func (p Period) String() string {
+	return string(p.appendText(nil))
+}
+
+func (p Period) appendText(b []byte) []byte {
+	if p.IsZero() {
+		return append(b, "P0D"...)
+	}
+	if p.Years <= 0 && p.Months <= 0 && p.Days <= 0 {
+		b = append(b, '-')
+		p = Period{-p.Years, -p.Months, -p.Days}
+	}
+	b = append(b, 'P')
+	if p.Years == 0 && p.Months == 0 && p.Days%7 == 0 {
+		b = strconv.AppendInt(b, int64(p.Days/7), 10)
+		return append(b, 'W')
+	}
+	for _, f := range []struct {
+		n          int
+		designator byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Days, 'D'}} {
+		if f.n != 0 {
+			b = strconv.AppendInt(b, int64(f.n), 10)
+			b = append(b, f.designator)
+		}
+	}
+	return b
+}
+
+// ParsePeriod parses a period in ISO 8601 duration syntax, such as
+// "P1Y2M10D" or "P3W", where weeks are converted to days. Components must
+// appear in the order years, months, weeks, days, and at least one must be
+// present. As an extension, the period and its components may be negated
+// with a minus sign, as in "-P1M" and "P1Y-2M", and weeks may be combined
+// with other components. Durations with a time part, such as "PT12H", are
+// not periods, and are rejected.
+//
+func ParsePeriod(s string) (Period, error) {
+	var p Period
+	errSyntax := fmt.Errorf("epochdate: invalid ISO 8601 period %q", s)
+
+	v := s
+	neg := strings.HasPrefix(v, "-")
+	if neg || strings.HasPrefix(v, "+") {
+		v = v[1:]
+	}
+	if v == "" || v[0] != 'P' {
+		return p, errSyntax
+	}
+	v = v[1:]
+	if v == "" {
+		return p, errSyntax
+	}
+
+	fields := []*int{&p.Years, &p.Months, new(int), &p.Days}
+	next := 0 // index in "YMWD" of the earliest allowed designator
+	for v != "" {
+		i := 0
+		if v[0] == '-' || v[0] == '+' {
+			i++
+		}
+		for i < len(v) && isDigit(v[i]) {
+			i++
+		}
+		if i == len(v) {
+			return p, errSyntax
+		}
+		n, err := strconv.ParseInt(v[:i], 10, 32)
+		if err != nil {
+			return p, errSyntax
+		}
+		k := strings.IndexByte("YMWD", v[i])
+		if k < next {
+			return p, errSyntax
+		}
+		*fields[k] = int(n)
+		next = k + 1
+		v = v[i+1:]
+	}
+
+	weeks := *fields[2]
+	if weeks > math.MaxInt32/7 || weeks < math.MinInt32/7 {
+		return Period{}, errSyntax
+	}
+	p.Days += 7 * weeks
+	if neg {
+		p = Period{-p.Years, -p.Months, -p.Days}
+	}
+	return p, nil
+}
+
+// Validate returns an error if a field of p is beyond the range accepted by
+// ParsePeriod, so that p would not survive encoding, or if its years and
+// months, or its days, exceed the span from 1970-01-01 to 2149-06-06, so
+// that AddPeriod would fail for every date. Periods need not be normalized;
+// a Period of 14 months is valid.
+//
+func (p Period) Validate() error {
+	for _, n := range []int{p.Years, p.Months, p.Days} {
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return errPeriodField
+		}
+	}
+	months := 12*int64(p.Years) + int64(p.Months)
+	maxMonths := int64(Date(maxDate).YearMonth())
+	if months < -maxMonths || months > maxMonths || p.Days < -maxDate || p.Days > maxDate {
+		return errPeriodSpan
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler, using the form returned by
+// String.
+//
+func (p Period) MarshalText() ([]byte, error) {
+	return p.appendText(nil), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the forms
+// parsed by ParsePeriod.
+//
+func (p *Period) UnmarshalText(b []byte) error {
+	v, err := ParsePeriod(string(b))
+	if err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// AddPeriod returns d plus p, or minus p if its fields are negative. The
+// years and months are added first, moving to the last day of the resulting
+// month if it is too short to contain the day of d, so that January 31 plus
+// one month is February 28 (or 29), rather than March 3 as with
+// time.Time.AddDate. The days are then added. ErrOutOfRange is returned if
+// the result is not representable.
+//
+func (d Date) AddPeriod(p Period) (Date, error) {
+	t := addMonths(d, 12*p.Years+p.Months) + int64(p.Days)*day
+	if !UnixInRange(t) {
+		return 0, ErrOutOfRange
+	}
+	return Date(t / day), nil
+}
+
+// PeriodBetween returns the period from a until b, in the greatest whole
+// number of months, normalized to years and months, followed by the
+// remaining days. It is calendar-correct, such that a.AddPeriod(p) always
+// returns b. The fields of the result are negative if b is before a, and
+// the result is then the negation of PeriodBetween(b, a), except where the
+// month-end rule of AddPeriod applies: from March 31 back to February 28 is
+// one month, while from February 28 forward to March 31 is one month and
+// three days.
+//
+func PeriodBetween(a, b Date) Period {
+	y1, m1, _ := a.Date()
+	y2, m2, _ := b.Date()
+	months := 12*(y2-y1) + int(m2-m1)
+	end := int64(b) * day
+	switch {
+	case b >= a && addMonths(a, months) > end:
+		months--
+	case b < a && addMonths(a, months) < end:
+		months++
+	}
+	days := int((end - addMonths(a, months)) / day)
+	return Period{months / 12, months % 12, days}
+}
+
+// addMonths returns the Unix time of d plus n months, clamping the day to
+// the end of the resulting month.
+//
+func addMonths(d Date, n int) int64 {
+	year, month, dom := d.Date()
+	month += time.Month(n)
+	if last := daysIn(year, month); dom > last {
+		dom = last
+	}
+	return time.Date(year, month, dom, 0, 0, 0, 0, time.UTC).Unix()
+}
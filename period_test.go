@@ -0,0 +1,190 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"testing/quick"
+)
+
+func TestPeriod_String(t *testing.T) {
+	tests := []struct {
+		p    Period
+		want string
+	}{
+		{Period{}, "P0D"},
+		{Period{1, 2, 10}, "P1Y2M10D"},
+		{Period{Months: 14}, "P14M"},
+		{Period{Days: 14}, "P2W"},
+		{Period{Days: 15}, "P15D"},
+		{Period{Years: 1, Days: 7}, "P1Y7D"},
+		{Period{Years: -1, Months: -2}, "-P1Y2M"},
+		{Period{Days: -21}, "-P3W"},
+		{Period{Years: 1, Months: -2}, "P1Y-2M"},
+	}
+	for _, tt := range tests {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q; want %q", tt.p, got, tt.want)
+		}
+		p, err := ParsePeriod(tt.want)
+		if err != nil || p != tt.p {
+			t.Errorf("ParsePeriod(%q) = %+v, %v; want %+v", tt.want, p, err, tt.p)
+		}
+	}
+}
+
+func TestParsePeriod(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Period
+	}{
+		{"P1Y", Period{Years: 1}},
+		{"P1W2D", Period{Days: 9}},
+		{"+P1M", Period{Months: 1}},
+		{"-P1Y-2M", Period{Years: -1, Months: 2}},
+		{"P0Y0M0D", Period{}},
+	}
+	for _, tt := range tests {
+		if got, err := ParsePeriod(tt.s); err != nil || got != tt.want {
+			t.Errorf("ParsePeriod(%q) = %+v, %v; want %+v", tt.s, got, err, tt.want)
+		}
+	}
+
+	for _, s := range []string{
+		"", "P", "1Y", "p1Y", "P1", "PY", "P1D1Y", "P1Y1Y", "P1X",
+		"PT1H", "P1DT1H", "P1.5Y", "--P1Y", "P--1Y", "P9999999999D", "P400000000W",
+	} {
+		if got, err := ParsePeriod(s); err == nil {
+			t.Errorf("ParsePeriod(%q) = %+v; want error", s, got)
+		}
+	}
+}
+
+func TestPeriod_JSON(t *testing.T) {
+	var v struct{ Term Period }
+	if err := json.Unmarshal([]byte(`{"Term":"P1Y6M"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Term != (Period{Years: 1, Months: 6}) {
+		t.Errorf("decoded %+v", v.Term)
+	}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `{"Term":"P1Y6M"}` {
+		t.Errorf("encoded %s, %v", b, err)
+	}
+	if err := json.Unmarshal([]byte(`{"Term":"1 year"}`), &v); err == nil {
+		t.Error("decoding invalid period succeeded")
+	}
+}
+
+func TestDate_AddPeriod(t *testing.T) {
+	tests := []struct {
+		date string
+		p    Period
+		want string
+	}{
+		{"2024-01-31", Period{Months: 1}, "2024-02-29"},
+		{"2023-01-31", Period{Months: 1}, "2023-02-28"},
+		{"2024-02-29", Period{Years: 1}, "2025-02-28"},
+		{"2024-01-31", Period{Months: 1, Days: 1}, "2024-03-01"},
+		{"2024-03-31", Period{Months: -1}, "2024-02-29"},
+		{"2024-05-15", Period{Years: -1, Months: -2, Days: -20}, "2023-02-23"},
+		{"2024-05-15", Period{Months: 14}, "2025-07-15"},
+		{"2149-05-31", Period{Days: 6}, "2149-06-06"},
+	}
+	for _, tt := range tests {
+		got, err := MustParseRFC(tt.date).AddPeriod(tt.p)
+		if err != nil || got.String() != tt.want {
+			t.Errorf("%s.AddPeriod(%v) = %v, %v; want %s", tt.date, tt.p, got, err, tt.want)
+		}
+	}
+	for _, p := range []Period{{Days: 6}, {Years: -180}} {
+		if got, err := MustParseRFC("2149-06-01").AddPeriod(p); err != ErrOutOfRange {
+			t.Errorf("AddPeriod(%v) = %v, %v; want ErrOutOfRange", p, got, err)
+		}
+	}
+}
+
+func TestPeriodBetween(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want Period
+	}{
+		{"2024-01-01", "2024-01-01", Period{}},
+		{"2024-01-15", "2025-03-20", Period{1, 2, 5}},
+		{"2024-01-31", "2024-02-29", Period{Months: 1}},
+		{"2024-01-31", "2024-03-01", Period{Months: 1, Days: 1}},
+		{"2023-02-28", "2023-03-31", Period{Months: 1, Days: 3}},
+		{"2023-03-31", "2023-02-28", Period{Months: -1}},
+		{"2025-03-20", "2024-01-15", Period{-1, -2, -5}},
+		{"2024-05-20", "2024-06-10", Period{Days: 21}},
+		{"2024-06-10", "2024-05-20", Period{Days: -21}},
+	}
+	for _, tt := range tests {
+		if got := PeriodBetween(MustParseRFC(tt.a), MustParseRFC(tt.b)); got != tt.want {
+			t.Errorf("PeriodBetween(%s, %s) = %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestPeriodBetween_roundTrip(t *testing.T) {
+	for i := 0; i <= maxDate; i += 97 {
+		for j := 0; j <= maxDate; j += 89 {
+			a, b := Date(i), Date(j)
+			p := PeriodBetween(a, b)
+			if got, err := a.AddPeriod(p); err != nil || got != b {
+				t.Fatalf("%v.AddPeriod(PeriodBetween(%v, %v) = %v) = %v, %v", a, a, b, p, got, err)
+			}
+			if p.Months/12 != 0 || (b >= a) != (p.Years >= 0 && p.Months >= 0 && p.Days >= 0) {
+				t.Fatalf("PeriodBetween(%v, %v) = %v is not normalized", a, b, p)
+			}
+		}
+	}
+}
+
+func TestPeriod_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       Period
+		wantErr bool
+	}{
+		{name: "zero", p: Period{}},
+		{name: "unnormalized", p: Period{Months: 14}},
+		{name: "mixed_signs", p: Period{Years: 200, Months: -2400}},
+		{name: "max_months", p: Period{Years: 179, Months: 5}},
+		{name: "min_months", p: Period{Months: -2153}},
+		{name: "max_days", p: Period{Days: 65535}},
+		{name: "months", p: Period{Years: 179, Months: 6}, wantErr: true},
+		{name: "negative_months", p: Period{Years: -180}, wantErr: true},
+		{name: "days", p: Period{Days: -65536}, wantErr: true},
+	}
+	if big := int64(math.MaxInt32) + 1; int64(int(big)) == big {
+		// Fields beyond 32 bits, which cannot be parsed, exist only where
+		// int is wider.
+		tests = append(tests, struct {
+			name    string
+			p       Period
+			wantErr bool
+		}{name: "field", p: Period{Years: int(big), Months: -12 * int(big)}, wantErr: true})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.p.Validate()
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("%v.Validate() = nil, want error", tt.p)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("%v.Validate() = %q, want nil", tt.p, err)
+			}
+		})
+	}
+
+	f := func(p Period) bool {
+		return p.Validate() == nil
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
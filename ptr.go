@@ -0,0 +1,37 @@
+package epochdate
+
+// ToPtr returns a pointer to a copy of d, for use with optional date fields
+// in API structs, which are commonly pointers so that absent values can be
+// omitted.
+//
+func ToPtr(d Date) *Date {
+	return &d
+}
+
+// NonZeroPtr is like ToPtr, except that it returns nil if d is the zero
+// Date, for code which uses the zero Date to mean "unset".
+//
+func NonZeroPtr(d Date) *Date {
+	if d.IsZero() {
+		return nil
+	}
+	return &d
+}
+
+// FromPtr returns the Date that p points to, or fallback if p is nil.
+func FromPtr(p *Date, fallback Date) Date {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// ValueOr returns d, or fallback if d is the zero Date, for code which uses
+// the zero Date to mean "unset".
+//
+func (d Date) ValueOr(fallback Date) Date {
+	if d.IsZero() {
+		return fallback
+	}
+	return d
+}
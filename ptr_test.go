@@ -0,0 +1,34 @@
+package epochdate
+
+import "testing"
+
+func TestPtr(t *testing.T) {
+	d := MustParseRFC("2024-03-01")
+	p := ToPtr(d)
+	if p == nil || *p != d {
+		t.Fatalf("ToPtr(%v) = %v", d, p)
+	}
+	if q := ToPtr(d); q == p {
+		t.Error("ToPtr returned the same pointer twice")
+	}
+	if got := FromPtr(p, 0); got != d {
+		t.Errorf("FromPtr(&%v, 0) = %v", d, got)
+	}
+	if got := FromPtr(nil, d); got != d {
+		t.Errorf("FromPtr(nil, %v) = %v", d, got)
+	}
+
+	if p := NonZeroPtr(0); p != nil {
+		t.Errorf("NonZeroPtr(0) = %v; want nil", *p)
+	}
+	if p := NonZeroPtr(d); p == nil || *p != d {
+		t.Errorf("NonZeroPtr(%v) = %v", d, p)
+	}
+
+	if got := Date(0).ValueOr(d); got != d {
+		t.Errorf("Date(0).ValueOr(%v) = %v", d, got)
+	}
+	if got := d.ValueOr(maxDate); got != d {
+		t.Errorf("%v.ValueOr(max) = %v", d, got)
+	}
+}
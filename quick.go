@@ -0,0 +1,87 @@
+package epochdate
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// Generate implements testing/quick.Generator, producing dates uniformly
+// distributed over the representable range, except that the minimum and
+// maximum dates are each produced more often, to exercise edge cases.
+//
+func (Date) Generate(r *rand.Rand, size int) reflect.Value {
+	var d Date
+	switch r.Intn(16) {
+	case 0:
+		d = 0
+	case 1:
+		d = maxDate
+	default:
+		d = Date(r.Intn(maxDate + 1))
+	}
+	return reflect.ValueOf(d)
+}
+
+// Generate implements testing/quick.Generator, producing YearMonth values
+// uniformly distributed over the range which is compatible with Date,
+// 1970-01 through 2149-06, except that the extremes of that range are each
+// produced more often, to exercise edge cases.
+//
+func (YearMonth) Generate(r *rand.Rand, size int) reflect.Value {
+	max := Date(maxDate).YearMonth()
+	var ym YearMonth
+	switch r.Intn(16) {
+	case 0:
+		ym = 0
+	case 1:
+		ym = max
+	default:
+		ym = YearMonth(r.Intn(int(max) + 1))
+	}
+	return reflect.ValueOf(ym)
+}
+
+// Generate implements testing/quick.Generator, producing non-empty ranges
+// whose bounds are distributed as by Date.Generate. Single-day ranges, and
+// ranges which are open on either or both sides, are each produced
+// regularly, to exercise edge cases.
+//
+func (DateRange) Generate(r *rand.Rand, size int) reflect.Value {
+	gen := func() Date {
+		return Date(0).Generate(r, size).Interface().(Date)
+	}
+	start, end := gen(), gen()
+	if end < start {
+		start, end = end, start
+	}
+	if r.Intn(16) == 0 {
+		end = start
+	}
+	v := DateRange{Start: start, End: end}
+	if r.Intn(8) == 0 {
+		v.Start, v.OpenStart = 0, true
+	}
+	if r.Intn(8) == 0 {
+		v.End, v.OpenEnd = maxDate, true
+	}
+	return reflect.ValueOf(v)
+}
+
+// Generate implements testing/quick.Generator, producing periods of up to
+// a century, with fields of either sign. Each field is zero a quarter of the
+// time, so that periods of only years, months, or days, including whole
+// weeks, are produced regularly.
+//
+func (Period) Generate(r *rand.Rand, size int) reflect.Value {
+	field := func(max int) int {
+		if r.Intn(4) == 0 {
+			return 0
+		}
+		return r.Intn(2*max+1) - max
+	}
+	return reflect.ValueOf(Period{
+		Years:  field(100),
+		Months: field(24),
+		Days:   field(400),
+	})
+}
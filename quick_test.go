@@ -0,0 +1,107 @@
+package epochdate
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+)
+
+var (
+	_ quick.Generator = Date(0)
+	_ quick.Generator = YearMonth(0)
+	_ quick.Generator = DateRange{}
+	_ quick.Generator = Period{}
+)
+
+func TestDate_Generate(t *testing.T) {
+	seen := make(map[Date]bool)
+	f := func(d Date) bool {
+		seen[d] = true
+		return d.String() == d.UTC().Format(RFC3339)
+	}
+
+	cfg := &quick.Config{MaxCount: 1000, Rand: rand.New(rand.NewSource(1))}
+	if err := quick.Check(f, cfg); err != nil {
+		t.Error(err)
+	}
+
+	if !seen[0] || !seen[maxDate] {
+		t.Errorf("Date.Generate did not produce both extremes")
+	}
+
+	if len(seen) < 500 {
+		t.Errorf("Date.Generate produced %d distinct values, want at least 500", len(seen))
+	}
+}
+
+func TestYearMonth_Generate(t *testing.T) {
+	max := Date(maxDate).YearMonth()
+	seen := make(map[YearMonth]bool)
+	f := func(ym YearMonth) bool {
+		seen[ym] = true
+		return ym <= max && ym.StartDate().YearMonth() == ym
+	}
+
+	cfg := &quick.Config{MaxCount: 1000, Rand: rand.New(rand.NewSource(1))}
+	if err := quick.Check(f, cfg); err != nil {
+		t.Error(err)
+	}
+
+	if !seen[0] || !seen[max] {
+		t.Errorf("YearMonth.Generate did not produce both extremes")
+	}
+}
+
+func TestDateRange_Generate(t *testing.T) {
+	var single, openStart, openEnd int
+	f := func(r DateRange) bool {
+		if r.Start == r.End {
+			single++
+		}
+		if r.OpenStart {
+			openStart++
+		}
+		if r.OpenEnd {
+			openEnd++
+		}
+		v, err := ParseDateRange(r.String())
+		return r.Len() > 0 && err == nil && v == r
+	}
+
+	cfg := &quick.Config{MaxCount: 1000, Rand: rand.New(rand.NewSource(1))}
+	if err := quick.Check(f, cfg); err != nil {
+		t.Error(err)
+	}
+
+	if single == 0 || openStart == 0 || openEnd == 0 {
+		t.Errorf("DateRange.Generate produced %d single-day, %d open-start, and %d open-end ranges", single, openStart, openEnd)
+	}
+}
+
+func TestPeriod_Generate(t *testing.T) {
+	seen := make(map[Period]bool)
+	var zero, weeks int
+	f := func(p Period) bool {
+		seen[p] = true
+		if p.IsZero() {
+			zero++
+		}
+		if p.Years == 0 && p.Months == 0 && p.Days != 0 && p.Days%7 == 0 {
+			weeks++
+		}
+		v, err := ParsePeriod(p.String())
+		return err == nil && v == p
+	}
+
+	cfg := &quick.Config{MaxCount: 1000, Rand: rand.New(rand.NewSource(1))}
+	if err := quick.Check(f, cfg); err != nil {
+		t.Error(err)
+	}
+
+	if zero == 0 || weeks == 0 {
+		t.Errorf("Period.Generate produced %d zero and %d whole-week periods", zero, weeks)
+	}
+	if len(seen) < 900 {
+		t.Errorf("Period.Generate produced %d distinct values, want at least 900", len(seen))
+	}
+}
@@ -0,0 +1,31 @@
+package epochdate
+
+import "math/rand"
+
+// RandomDate returns a date chosen uniformly from the range [min, max],
+// using r as the source of randomness, or the default source of the
+// math/rand package if r is nil. It panics if min is after max.
+//
+func RandomDate(r *rand.Rand, min, max Date) Date {
+	if min > max {
+		panic("epochdate: RandomDate called with min after max")
+	}
+	n := int(max-min) + 1
+	if r == nil {
+		return min + Date(rand.Intn(n))
+	}
+	return min + Date(r.Intn(n))
+}
+
+// RandomRange returns the bounds of a range of dates within [min, max],
+// whose endpoints are two dates chosen independently and uniformly from that
+// range, ordered such that start <= end. The randomness source is determined
+// as with RandomDate. It panics if min is after max.
+//
+func RandomRange(r *rand.Rand, min, max Date) (start, end Date) {
+	start, end = RandomDate(r, min, max), RandomDate(r, min, max)
+	if start > end {
+		start, end = end, start
+	}
+	return start, end
+}
@@ -0,0 +1,31 @@
+//go:build go1.22
+// +build go1.22
+
+package epochdate
+
+import randv2 "math/rand/v2"
+
+// RandomDateV2 is like RandomDate, but uses a math/rand/v2 source, or the
+// default source of that package if r is nil.
+//
+func RandomDateV2(r *randv2.Rand, min, max Date) Date {
+	if min > max {
+		panic("epochdate: RandomDateV2 called with min after max")
+	}
+	n := int(max-min) + 1
+	if r == nil {
+		return min + Date(randv2.IntN(n))
+	}
+	return min + Date(r.IntN(n))
+}
+
+// RandomRangeV2 is like RandomRange, but uses a math/rand/v2 source, or the
+// default source of that package if r is nil.
+//
+func RandomRangeV2(r *randv2.Rand, min, max Date) (start, end Date) {
+	start, end = RandomDateV2(r, min, max), RandomDateV2(r, min, max)
+	if start > end {
+		start, end = end, start
+	}
+	return start, end
+}
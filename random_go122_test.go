@@ -0,0 +1,34 @@
+//go:build go1.22
+// +build go1.22
+
+package epochdate
+
+import (
+	randv2 "math/rand/v2"
+	"testing"
+)
+
+func TestRandomDateV2(t *testing.T) {
+	r := randv2.New(randv2.NewPCG(1, 2))
+	seen := make(map[Date]bool)
+	for i := 0; i < 1000; i++ {
+		d := RandomDateV2(r, 100, 102)
+		if d < 100 || d > 102 {
+			t.Fatalf("RandomDateV2(r, 100, 102) = %d, out of range", d)
+		}
+		seen[d] = true
+
+		start, end := RandomRangeV2(nil, 0, maxDate)
+		if start > end {
+			t.Fatalf("RandomRangeV2(nil, 0, max) = %d, %d, out of order", start, end)
+		}
+	}
+
+	if len(seen) != 3 {
+		t.Errorf("RandomDateV2(r, 100, 102) produced %d distinct values, want 3", len(seen))
+	}
+
+	if !try(func() { RandomDateV2(nil, 2, 1) }) {
+		t.Errorf("RandomDateV2(nil, 2, 1) should have panicked")
+	}
+}
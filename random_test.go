@@ -0,0 +1,57 @@
+package epochdate
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandomDate(t *testing.T) {
+	tests := []struct {
+		name string
+		min  Date
+		max  Date
+	}{
+		{
+			name: "single",
+			min:  100,
+			max:  100,
+		},
+		{
+			name: "small",
+			min:  100,
+			max:  102,
+		},
+		{
+			name: "full",
+			min:  0,
+			max:  maxDate,
+		},
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := make(map[Date]bool)
+			for i := 0; i < 1000; i++ {
+				d := RandomDate(r, tt.min, tt.max)
+				if d < tt.min || d > tt.max {
+					t.Fatalf("RandomDate(r, %d, %d) = %d, out of range", tt.min, tt.max, d)
+				}
+				seen[d] = true
+
+				start, end := RandomRange(nil, tt.min, tt.max)
+				if start < tt.min || end > tt.max || start > end {
+					t.Fatalf("RandomRange(nil, %d, %d) = %d, %d, out of range", tt.min, tt.max, start, end)
+				}
+			}
+
+			if n := int(tt.max-tt.min) + 1; n <= 3 && len(seen) != n {
+				t.Errorf("RandomDate(r, %d, %d) produced %d distinct values, want %d", tt.min, tt.max, len(seen), n)
+			}
+		})
+	}
+
+	if !try(func() { RandomDate(r, 2, 1) }) {
+		t.Errorf("RandomDate(r, 2, 1) should have panicked")
+	}
+}
@@ -0,0 +1,82 @@
+package epochdate
+
+import "fmt"
+
+const (
+	redactedDate  = "****-**-**"
+	redactedMonth = "-**-**"
+)
+
+// Redacted holds a sensitive Date, such as a birthdate, which is masked
+// whenever it is formatted, logged, or encoded, so that it cannot leak into
+// logs or responses by accident. The date itself is available only through
+// Unwrap. Depending on how it was created, a Redacted either masks the
+// entire date ("****-**-**"), or reveals only the year ("1987-**-**").
+//
+// The zero value holds 1970-01-01, fully masked.
+//
+type Redacted struct {
+	d        Date
+	showYear bool
+}
+
+// Redact returns a Redacted holding d, which masks the entire date.
+func Redact(d Date) Redacted {
+	return Redacted{d: d}
+}
+
+// RedactKeepYear returns a Redacted holding d, which reveals only its year.
+func RedactKeepYear(d Date) Redacted {
+	return Redacted{d: d, showYear: true}
+}
+
+// Unwrap returns the date held by r.
+func (r Redacted) Unwrap() Date {
+	return r.d
+}
+
+// String returns the masked representation of r.
+func (r Redacted) String() string {
+	if r.showYear {
+		return r.d.Format("2006") + redactedMonth
+	}
+	return redactedDate
+}
+
+// GoString returns the masked representation of r, for the %#v verb.
+func (r Redacted) GoString() string {
+	return "epochdate.Redacted(" + r.String() + ")"
+}
+
+// Format implements fmt.Formatter, so that every verb, including %d and
+// %+v, which would otherwise print the fields of r, yields the masked
+// representation.
+//
+func (r Redacted) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, r.GoString())
+		return
+	}
+	fmt.Fprint(f, r.String())
+}
+
+// MarshalText implements encoding.TextMarshaler, producing the masked
+// representation, so that JSON, XML, and other encodings are masked.
+//
+func (r Redacted) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, decoding an unmasked
+// date in the same forms as Date.UnmarshalText, so that sensitive dates may
+// be received as Redacted values. The result masks the entire date, unless r
+// already revealed its year.
+//
+func (r *Redacted) UnmarshalText(data []byte) error {
+	var d Date
+	if err := d.UnmarshalText(data); err != nil {
+		return err
+	}
+	r.d = d
+	return nil
+}
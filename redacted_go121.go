@@ -0,0 +1,13 @@
+//go:build go1.21
+// +build go1.21
+
+package epochdate
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, logging the masked representation of
+// r.
+//
+func (r Redacted) LogValue() slog.Value {
+	return slog.StringValue(r.String())
+}
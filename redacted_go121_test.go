@@ -0,0 +1,20 @@
+//go:build go1.21
+// +build go1.21
+
+package epochdate
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestRedactedLogValue(t *testing.T) {
+	var b bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&b, nil))
+	logger.Info("signup", "birthdate", Redact(MustParseRFC("1987-06-15")))
+	if !strings.Contains(b.String(), `"birthdate":"****-**-**"`) {
+		t.Errorf("log output = %s", b.String())
+	}
+}
@@ -0,0 +1,54 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestRedacted(t *testing.T) {
+	d := MustParseRFC("1987-06-15")
+	tests := []struct {
+		r    Redacted
+		want string
+	}{
+		{Redact(d), "****-**-**"},
+		{RedactKeepYear(d), "1987-**-**"},
+	}
+	for _, tt := range tests {
+		if tt.r.Unwrap() != d {
+			t.Errorf("Unwrap() = %v, want %v", tt.r.Unwrap(), d)
+		}
+		for _, verb := range []string{"%v", "%s", "%d", "%+v", "%q"} {
+			if got := fmt.Sprintf(verb, tt.r); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", verb, got, tt.want)
+			}
+		}
+		if got, want := fmt.Sprintf("%#v", tt.r), "epochdate.Redacted("+tt.want+")"; got != want {
+			t.Errorf("Sprintf(%%#v) = %q, want %q", got, want)
+		}
+
+		b, err := json.Marshal(struct{ Birthdate Redacted }{tt.r})
+		if want := `{"Birthdate":"` + tt.want + `"}`; err != nil || string(b) != want {
+			t.Errorf("json.Marshal = %s, %v; want %s", b, err, want)
+		}
+	}
+}
+
+func TestRedactedUnmarshal(t *testing.T) {
+	var v struct{ Birthdate Redacted }
+	if err := json.Unmarshal([]byte(`{"Birthdate":"1987-06-15"}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Birthdate.Unwrap() != MustParseRFC("1987-06-15") || v.Birthdate.String() != "****-**-**" {
+		t.Errorf("Unmarshal = %v (%v)", v.Birthdate, v.Birthdate.Unwrap())
+	}
+
+	r := RedactKeepYear(0)
+	if err := r.UnmarshalText([]byte("1987-06-15")); err != nil || r.String() != "1987-**-**" {
+		t.Errorf("UnmarshalText = %v, %v", r, err)
+	}
+	if err := r.UnmarshalText([]byte("****-**-**")); err == nil {
+		t.Error("UnmarshalText of masked date succeeded")
+	}
+}
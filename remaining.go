@@ -0,0 +1,35 @@
+package epochdate
+
+import "time"
+
+// DaysLeftInMonth returns the number of days after d in its month, such that
+// the result is zero on the last day of the month. The days counted need not
+// be representable, so the result is 24 for 2149-06-06.
+//
+func (d Date) DaysLeftInMonth() int {
+	year, month, dom := d.Date()
+	return daysIn(year, month) - dom
+}
+
+// DaysLeftInQuarter returns the number of days after d in its calendar
+// quarter, such that the result is zero on March 31, June 30, September 30,
+// and December 31. As with DaysLeftInMonth, the days counted need not be
+// representable.
+//
+func (d Date) DaysLeftInQuarter() int {
+	year, month, dom := d.Date()
+	n := daysIn(year, month) - dom
+	for m := month + 1; (m-1)/3 == (month-1)/3; m++ {
+		n += daysIn(year, m)
+	}
+	return n
+}
+
+// DaysLeftInYear returns the number of days after d in its year, such that
+// the result is zero on December 31. As with DaysLeftInMonth, the days
+// counted need not be representable.
+//
+func (d Date) DaysLeftInYear() int {
+	t := d.UTC()
+	return time.Date(t.Year(), time.December, 31, 0, 0, 0, 0, time.UTC).YearDay() - t.YearDay()
+}
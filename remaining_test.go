@@ -0,0 +1,31 @@
+package epochdate
+
+import "testing"
+
+func TestDaysLeft(t *testing.T) {
+	tests := []struct {
+		date                 string
+		month, quarter, year int
+	}{
+		{"1970-01-01", 30, 89, 364},
+		{"2024-01-31", 0, 60, 335},
+		{"2024-02-01", 28, 59, 334},
+		{"2023-02-01", 27, 58, 333},
+		{"2024-03-31", 0, 0, 275},
+		{"2024-05-15", 16, 46, 230},
+		{"2024-12-31", 0, 0, 0},
+		{"2149-06-06", 24, 24, 208},
+	}
+	for _, tt := range tests {
+		d := MustParseRFC(tt.date)
+		if got := d.DaysLeftInMonth(); got != tt.month {
+			t.Errorf("%s.DaysLeftInMonth() = %d; want %d", tt.date, got, tt.month)
+		}
+		if got := d.DaysLeftInQuarter(); got != tt.quarter {
+			t.Errorf("%s.DaysLeftInQuarter() = %d; want %d", tt.date, got, tt.quarter)
+		}
+		if got := d.DaysLeftInYear(); got != tt.year {
+			t.Errorf("%s.DaysLeftInYear() = %d; want %d", tt.date, got, tt.year)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package epochdate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RenderOptions configures RenderMonth and RenderYear. The zero value
+// renders weeks beginning on Sunday, without highlights.
+//
+type RenderOptions struct {
+	// WeekStart is the first day of each week.
+	WeekStart time.Weekday
+
+	// Highlight, if non-nil, reports which dates to mark, such as
+	// DateSet.Contains, or a function comparing against a single date.
+	Highlight func(Date) bool
+
+	// Marker is written after each highlighted day. Since every day is
+	// followed by one column, it should be a single column wide. An empty
+	// Marker is "*".
+	Marker string
+}
+
+// monthWidth is the width of the lines of a rendered month: seven days of
+// two digits, each followed by a space or marker.
+const monthWidth = 7 * 3
+
+// RenderMonth returns the calendar of ym as plain text, in the style of the
+// Unix cal command:
+//
+//	   January 2024
+//	Su Mo Tu We Th Fr Sa
+//	    1  2  3  4  5  6
+//	 7  8  9 10 11 12 13
+//	...
+//
+// Highlighted days are followed by the marker, such as "15*". Each line ends
+// with a newline, and has no trailing spaces.
+//
+func RenderMonth(ym YearMonth, opts RenderOptions) string {
+	lines := renderMonth(ym, opts, false)
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(line, " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// RenderYear returns the calendar of every month of the given year as plain
+// text, in the style of RenderMonth, with three months across. Months which
+// are not representable as YearMonths are omitted.
+//
+func RenderYear(year int, opts RenderOptions) string {
+	var b strings.Builder
+	title := strconv.Itoa(year)
+	b.WriteString(center(title, 3*monthWidth+2))
+	b.WriteString("\n\n")
+	for row := 0; row < 4; row++ {
+		var months [][]string
+		for col := 0; col < 3; col++ {
+			ym, err := newYearMonth(year, time.Month(3*row+col+1))
+			if err != nil {
+				continue
+			}
+			months = append(months, renderMonth(ym, opts, true))
+		}
+		if len(months) == 0 {
+			continue
+		}
+		if row > 0 {
+			b.WriteByte('\n')
+		}
+		for i := range months[0] {
+			var line string
+			for j, m := range months {
+				if j > 0 {
+					line += " "
+				}
+				line += m[i]
+			}
+			b.WriteString(strings.TrimRight(line, " "))
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// renderMonth returns the lines of the calendar of ym, each padded to
+// monthWidth. The title omits the year if inYear is true, in which case
+// there are always six weeks, so that months may be placed side by side.
+//
+func renderMonth(ym YearMonth, opts RenderOptions, inYear bool) []string {
+	year, month := ym.Year(), ym.Month()
+	marker := opts.Marker
+	if marker == "" {
+		marker = "*"
+	}
+
+	title := month.String()
+	if !inYear {
+		title += " " + strconv.Itoa(year)
+	}
+	lines := []string{pad(center(title, monthWidth-1))}
+
+	var header []string
+	for i := 0; i < 7; i++ {
+		header = append(header, ((opts.WeekStart + time.Weekday(i)) % 7).String()[:2])
+	}
+	lines = append(lines, pad(strings.Join(header, " ")))
+
+	// Compute the first weekday from the day number, since the month may
+	// not be representable as dates.
+	first := int(ym.StartTime(time.UTC).Unix() / day)
+	offset := ((first+4)%7 - int(opts.WeekStart) + 14) % 7
+	days := daysIn(year, month)
+
+	line := strings.Repeat("   ", offset)
+	for dom := 1; dom <= days; dom++ {
+		n := first + dom - 1
+		sep := " "
+		if opts.Highlight != nil && n >= 0 && n <= maxDate && opts.Highlight(Date(n)) {
+			sep = marker
+		}
+		if dom < 10 {
+			line += " "
+		}
+		line += strconv.Itoa(dom) + sep
+		if (offset+dom)%7 == 0 || dom == days {
+			lines = append(lines, pad(line))
+			line = ""
+		}
+	}
+	for inYear && len(lines) < 8 {
+		lines = append(lines, pad(""))
+	}
+	return lines
+}
+
+// center returns s centered within width columns, without trailing spaces.
+func center(s string, width int) string {
+	if n := (width - len(s)) / 2; n > 0 {
+		return strings.Repeat(" ", n) + s
+	}
+	return s
+}
+
+// pad returns s padded with spaces to monthWidth columns.
+func pad(s string) string {
+	if n := monthWidth - len(s); n > 0 {
+		return s + strings.Repeat(" ", n)
+	}
+	return s
+}
@@ -0,0 +1,69 @@
+package epochdate
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderMonth(t *testing.T) {
+	marked := NewDateSet(MustParseRFC("2024-01-15"), MustParseRFC("2024-01-31"))
+	got := RenderMonth(ClampYearMonth(2024, time.January), RenderOptions{Highlight: marked.Contains})
+	want := "    January 2024\n" +
+		"Su Mo Tu We Th Fr Sa\n" +
+		"    1  2  3  4  5  6\n" +
+		" 7  8  9 10 11 12 13\n" +
+		"14 15*16 17 18 19 20\n" +
+		"21 22 23 24 25 26 27\n" +
+		"28 29 30 31*\n"
+	if got != want {
+		t.Errorf("RenderMonth =\n%s\nwant\n%s", got, want)
+	}
+
+	got = RenderMonth(ClampYearMonth(2024, time.September), RenderOptions{WeekStart: time.Monday})
+	want = "   September 2024\n" +
+		"Mo Tu We Th Fr Sa Su\n" +
+		"                   1\n" +
+		" 2  3  4  5  6  7  8\n" +
+		" 9 10 11 12 13 14 15\n" +
+		"16 17 18 19 20 21 22\n" +
+		"23 24 25 26 27 28 29\n" +
+		"30\n"
+	if got != want {
+		t.Errorf("RenderMonth =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderMonth_beyondDates(t *testing.T) {
+	// July 2149 is a representable YearMonth, but its dates are not.
+	called := false
+	got := RenderMonth(ClampYearMonth(2149, time.July), RenderOptions{
+		Highlight: func(Date) bool { called = true; return true },
+	})
+	if called {
+		t.Error("Highlight called for unrepresentable dates")
+	}
+	if !strings.HasPrefix(got, "     July 2149\n") || !strings.HasSuffix(got, "31\n") {
+		t.Errorf("RenderMonth =\n%s", got)
+	}
+}
+
+func TestRenderYear(t *testing.T) {
+	got := RenderYear(2024, RenderOptions{})
+	lines := strings.Split(got, "\n")
+	if strings.TrimSpace(lines[0]) != "2024" {
+		t.Errorf("title = %q; want 2024", lines[0])
+	}
+	wantRow := "Su Mo Tu We Th Fr Sa  Su Mo Tu We Th Fr Sa  Su Mo Tu We Th Fr Sa"
+	if lines[3] != wantRow {
+		t.Errorf("header = %q; want %q", lines[3], wantRow)
+	}
+	if want := "28 29 30 31           25 26 27 28 29        24 25 26 27 28 29 30"; lines[8] != want {
+		t.Errorf("last week of first quarter = %q; want %q", lines[8], want)
+	}
+	for _, month := range []string{"January", "April", "July", "October", "December"} {
+		if !strings.Contains(got, month) {
+			t.Errorf("RenderYear does not contain %s", month)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package epochdate
+
+import "time"
+
+// AnchorPolicy specifies how a schedule anchored on a day of the month
+// handles months too short to contain that day.
+//
+type AnchorPolicy int
+
+const (
+	// AnchorClamp moves dates to the last day of short months, while
+	// keeping the anchor day for later months: a schedule starting on
+	// January 31 continues on February 29 (or 28), March 31, April 30, and
+	// so on.
+	AnchorClamp AnchorPolicy = iota
+
+	// AnchorOverflow carries dates into the following month, as
+	// time.Time.AddDate does: a schedule starting on January 31 continues
+	// on March 3 (or March 2 in leap years), March 31, May 1, and so on.
+	AnchorOverflow
+
+	// AnchorEndOfMonth keeps schedules starting on the last day of a month
+	// on the last day of each month: a schedule starting on February 29
+	// continues on March 31, April 30, and so on. Other schedules behave as
+	// for AnchorClamp.
+	AnchorEndOfMonth
+)
+
+// Adjustment specifies how scheduled dates that are not business days are
+// moved, according to the conventions used in finance.
+//
+type Adjustment int
+
+const (
+	// AdjustNone leaves dates unchanged.
+	AdjustNone Adjustment = iota
+
+	// AdjustFollowing moves dates to the following business day.
+	AdjustFollowing
+
+	// AdjustModifiedFollowing moves dates to the following business day,
+	// unless it is in the next month, in which case dates are moved to the
+	// preceding business day.
+	AdjustModifiedFollowing
+
+	// AdjustPreceding moves dates to the preceding business day.
+	AdjustPreceding
+)
+
+// Adjust returns d moved to a business day according to cal and the
+// adjustment a. ErrOutOfRange is returned if there is no such representable
+// date.
+//
+func (a Adjustment) Adjust(cal CalendarProvider, d Date) (Date, error) {
+	if a == AdjustNone || IsBusinessDay(cal, d) {
+		return d, nil
+	}
+	switch a {
+	case AdjustFollowing:
+		return NextBusinessDay(cal, d)
+
+	case AdjustModifiedFollowing:
+		next, err := NextBusinessDay(cal, d)
+		if err == nil && next.YearMonth() == d.YearMonth() {
+			return next, nil
+		}
+		return AddBusinessDays(cal, d, -1)
+	}
+	return AddBusinessDays(cal, d, -1)
+}
+
+// RenewalSchedule describes the renewal dates of a subscription, or other
+// recurring cycle, which occur every Months months from Start, such as 1
+// for monthly, 3 for quarterly, and 12 for annual renewals.
+//
+// Each renewal date is computed from Start, rather than from the previous
+// renewal, so that dates do not drift when months are short. Renewals
+// falling on non-business days of Calendar are moved according to Adjust;
+// Calendar is ignored if Adjust is AdjustNone. A nil Calendar is treated as
+// having Saturday and Sunday weekends and no holidays.
+//
+type RenewalSchedule struct {
+	Start    Date
+	Months   int
+	Anchor   AnchorPolicy
+	Adjust   Adjustment
+	Calendar CalendarProvider
+}
+
+// At returns the nth renewal date of s, where the 0th is Start itself
+// (subject to adjustment). It returns ErrOutOfRange if the date is not
+// representable.
+//
+func (s RenewalSchedule) At(n int) (Date, error) {
+	year, month, dom := s.Start.Date()
+	month += time.Month(n * s.Months)
+	last := daysIn(year, month)
+	switch {
+	case s.Anchor == AnchorEndOfMonth && s.Start.IsLastDayOfMonth():
+		dom = last
+
+	case s.Anchor != AnchorOverflow && dom > last:
+		dom = last
+	}
+	t := time.Date(year, month, dom, 0, 0, 0, 0, time.UTC).Unix()
+	if !UnixInRange(t) {
+		return 0, ErrOutOfRange
+	}
+	cal := s.Calendar
+	if cal == nil {
+		cal = weekendsOnly
+	}
+	return s.Adjust.Adjust(cal, Date(t/day))
+}
+
+// weekendsOnly is the calendar used by a RenewalSchedule with no Calendar.
+var weekendsOnly = HolidayCalendar{Weekend: SaturdaySunday}
+
+// Iter returns an iterator over the renewal dates of s, beginning with
+// Start.
+//
+func (s RenewalSchedule) Iter() *RenewalIterator {
+	return &RenewalIterator{s: s}
+}
+
+// RenewalIterator enumerates renewal dates lazily:
+//
+//	it := schedule.Iter()
+//	for d, ok := it.Next(); ok && d <= horizon; d, ok = it.Next() {
+//		...
+//	}
+//
+type RenewalIterator struct {
+	s    RenewalSchedule
+	n    int
+	done bool
+}
+
+// Next returns the next renewal date, and true, or false if the remaining
+// renewals are not representable, or s.Months is not positive.
+//
+func (it *RenewalIterator) Next() (Date, bool) {
+	if it.done || it.s.Months <= 0 && it.n > 0 {
+		return 0, false
+	}
+	d, err := it.s.At(it.n)
+	if err != nil {
+		it.done = true
+		return 0, false
+	}
+	it.n++
+	return d, true
+}
+
+// IsLastDayOfMonth reports whether d is the last day of its month.
+func (d Date) IsLastDayOfMonth() bool {
+	year, month, day := d.Date()
+	return day == daysIn(year, month)
+}
@@ -0,0 +1,106 @@
+package epochdate
+
+import "testing"
+
+func collect(s RenewalSchedule, max int) []string {
+	var dates []string
+	it := s.Iter()
+	for d, ok := it.Next(); ok && len(dates) < max; d, ok = it.Next() {
+		dates = append(dates, d.String())
+	}
+	return dates
+}
+
+func TestRenewalSchedule(t *testing.T) {
+	jan31 := MustParseRFC("2024-01-31")
+	feb29 := MustParseRFC("2024-02-29")
+	tests := []struct {
+		name string
+		s    RenewalSchedule
+		want []string
+	}{
+		{
+			"monthly_clamp",
+			RenewalSchedule{Start: jan31, Months: 1},
+			[]string{"2024-01-31", "2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31"},
+		},
+		{
+			"monthly_overflow",
+			RenewalSchedule{Start: jan31, Months: 1, Anchor: AnchorOverflow},
+			[]string{"2024-01-31", "2024-03-02", "2024-03-31", "2024-05-01", "2024-05-31"},
+		},
+		{
+			"monthly_overflow_non_leap",
+			RenewalSchedule{Start: MustParseRFC("2023-01-31"), Months: 1, Anchor: AnchorOverflow},
+			[]string{"2023-01-31", "2023-03-03", "2023-03-31", "2023-05-01", "2023-05-31"},
+		},
+		{
+			"monthly_end_of_month",
+			RenewalSchedule{Start: feb29, Months: 1, Anchor: AnchorEndOfMonth},
+			[]string{"2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31", "2024-06-30"},
+		},
+		{
+			"monthly_clamp_feb29",
+			RenewalSchedule{Start: feb29, Months: 1},
+			[]string{"2024-02-29", "2024-03-29", "2024-04-29", "2024-05-29", "2024-06-29"},
+		},
+		{
+			"annual",
+			RenewalSchedule{Start: feb29, Months: 12},
+			[]string{"2024-02-29", "2025-02-28", "2026-02-28", "2027-02-28", "2028-02-29"},
+		},
+		{
+			"quarterly_following",
+			RenewalSchedule{Start: MustParseRFC("2024-03-30"), Months: 3, Adjust: AdjustFollowing, Calendar: testCalendar},
+			[]string{"2024-04-01", "2024-07-01", "2024-09-30", "2024-12-30", "2025-03-31"},
+		},
+		{
+			"quarterly_following_nil_calendar",
+			RenewalSchedule{Start: MustParseRFC("2024-03-30"), Months: 3, Adjust: AdjustFollowing},
+			[]string{"2024-04-01", "2024-07-01", "2024-09-30", "2024-12-30", "2025-03-31"},
+		},
+		{
+			"quarterly_modified_following",
+			RenewalSchedule{Start: MustParseRFC("2024-03-30"), Months: 3, Adjust: AdjustModifiedFollowing, Calendar: testCalendar},
+			[]string{"2024-03-29", "2024-06-28", "2024-09-30", "2024-12-30", "2025-03-31"},
+		},
+		{
+			"quarterly_preceding",
+			RenewalSchedule{Start: MustParseRFC("2024-03-30"), Months: 3, Adjust: AdjustPreceding, Calendar: testCalendar},
+			[]string{"2024-03-29", "2024-06-28", "2024-09-30", "2024-12-30", "2025-03-28"},
+		},
+		{
+			"end_of_range",
+			RenewalSchedule{Start: MustParseRFC("2149-03-06"), Months: 1},
+			[]string{"2149-03-06", "2149-04-06", "2149-05-06", "2149-06-06"},
+		},
+		{
+			"no_interval",
+			RenewalSchedule{Start: jan31},
+			[]string{"2024-01-31"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := collect(tt.s, 5)
+			if len(got) != len(tt.want) {
+				t.Fatalf("renewals = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("renewals = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenewalScheduleAt(t *testing.T) {
+	s := RenewalSchedule{Start: MustParseRFC("2024-01-31"), Months: 1}
+	if d, err := s.At(-1); err != nil || d.String() != "2023-12-31" {
+		t.Errorf("At(-1) = %v, %v", d, err)
+	}
+	if d, err := s.At(12 * 200); err != ErrOutOfRange {
+		t.Errorf("At(2400) = %v, %v; want ErrOutOfRange", d, err)
+	}
+}
@@ -0,0 +1,122 @@
+package epochdate
+
+// Regular expressions matching the text encodings of Date, YearMonth,
+// DateRange, and Period. They constrain the syntax, but not the range, of
+// values.
+//
+const (
+	datePattern      = `^` + dateSyntax + `$`
+	yearMonthPattern = `^[0-9]{4}-(0[1-9]|1[0-2])$`
+	dateRangePattern = `^(` + dateSyntax + `|\.\.)/(` + dateSyntax + `|\.\.)$`
+
+	// periodPattern requires at least one component, in the order parsed
+	// by ParsePeriod, without relying on lookahead, which not every
+	// validator supports.
+	periodPattern = `^[-+]?P(` +
+		periodComponent + `Y(` + periodComponent + `M)?(` + periodComponent + `W)?(` + periodComponent + `D)?|` +
+		periodComponent + `M(` + periodComponent + `W)?(` + periodComponent + `D)?|` +
+		periodComponent + `W(` + periodComponent + `D)?|` +
+		periodComponent + `D)$`
+
+	dateSyntax      = `[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])`
+	periodComponent = `[-+]?[0-9]+`
+)
+
+// DateJSONSchema returns a JSON Schema describing the JSON encoding of Date.
+// Besides the standard "date" format and a pattern, the schema holds the
+// representable range as formatMinimum and formatMaximum, which are
+// understood by some validators, such as ajv-formats. A new map is returned
+// on each call, so callers may add to it, for example a title or default.
+//
+func DateJSONSchema() map[string]interface{} {
+	s := DateOpenAPISchema()
+	s["formatMinimum"] = Date(0).String()
+	s["formatMaximum"] = Date(maxDate).String()
+	return s
+}
+
+// DateOpenAPISchema returns an OpenAPI 3 schema object describing the JSON
+// encoding of Date. OpenAPI has no keywords for bounding strings, so the
+// representable range is stated in the description.
+//
+func DateOpenAPISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"format":      "date",
+		"pattern":     datePattern,
+		"minLength":   len(RFC3339),
+		"maxLength":   len(RFC3339),
+		"example":     "2020-01-26",
+		"description": "A date from " + Date(0).String() + " through " + Date(maxDate).String() + ", inclusive.",
+	}
+}
+
+// YearMonthJSONSchema returns a JSON Schema describing the JSON encoding of
+// YearMonth. It is identical to the schema returned by
+// YearMonthOpenAPISchema, since neither has a standard format for months.
+//
+func YearMonthJSONSchema() map[string]interface{} {
+	return YearMonthOpenAPISchema()
+}
+
+// YearMonthOpenAPISchema returns an OpenAPI 3 schema object describing the
+// JSON encoding of YearMonth. The schema does not admit the year-month-day
+// form accepted by UnmarshalText, since it is never produced by MarshalText.
+//
+func YearMonthOpenAPISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"pattern":     yearMonthPattern,
+		"minLength":   len(rfc3339YearMonth),
+		"maxLength":   len(rfc3339YearMonth),
+		"example":     "2020-01",
+		"description": "A month from " + YearMonth(0).String() + " through " + YearMonth(maxDate).String() + ", inclusive.",
+	}
+}
+
+// DateRangeJSONSchema returns a JSON Schema describing the JSON encoding of
+// DateRange. It is identical to the schema returned by
+// DateRangeOpenAPISchema, since neither has a standard format for intervals
+// of dates.
+//
+func DateRangeJSONSchema() map[string]interface{} {
+	return DateRangeOpenAPISchema()
+}
+
+// DateRangeOpenAPISchema returns an OpenAPI 3 schema object describing the
+// JSON encoding of DateRange, an ISO 8601 interval of dates in which either
+// bound may be open. The pattern does not require the end to follow the
+// start; Validate checks that.
+//
+func DateRangeOpenAPISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"pattern":     dateRangePattern,
+		"minLength":   len("../.."),
+		"maxLength":   2*len(RFC3339) + 1,
+		"example":     "2020-01-26/2020-12-31",
+		"description": "An inclusive range of dates from " + Date(0).String() + " through " + Date(maxDate).String() + `, as "start/end", where an open bound is written "..".`,
+	}
+}
+
+// PeriodJSONSchema returns a JSON Schema describing the JSON encoding of
+// Period. It is identical to the schema returned by PeriodOpenAPISchema,
+// since the standard "duration" format admits durations with a time part,
+// which are not periods.
+//
+func PeriodJSONSchema() map[string]interface{} {
+	return PeriodOpenAPISchema()
+}
+
+// PeriodOpenAPISchema returns an OpenAPI 3 schema object describing the
+// JSON encoding of Period, an ISO 8601 duration in years, months, weeks, and
+// days, whose components may be negated as an extension.
+//
+func PeriodOpenAPISchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":        "string",
+		"pattern":     periodPattern,
+		"example":     "P1Y2M10D",
+		"description": "An ISO 8601 period of years, months, weeks, and days, without a time part.",
+	}
+}
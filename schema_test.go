@@ -0,0 +1,106 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"testing/quick"
+)
+
+func TestDateSchema(t *testing.T) {
+	s := DateJSONSchema()
+	if s["format"] != "date" || s["formatMinimum"] != "1970-01-01" || s["formatMaximum"] != "2149-06-06" {
+		t.Errorf("DateJSONSchema() = %v", s)
+	}
+	if _, ok := DateOpenAPISchema()["formatMinimum"]; ok {
+		t.Error("DateOpenAPISchema() has formatMinimum")
+	}
+
+	s["title"] = "changed"
+	if _, ok := DateJSONSchema()["title"]; ok {
+		t.Error("DateJSONSchema() returned a shared map")
+	}
+
+	re := regexp.MustCompile(datePattern)
+	f := func(d Date) bool {
+		b, _ := d.MarshalText()
+		return re.Match(b)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	for _, s := range []string{"2020-1-26", "2020-13-01", "2020-01-32", "2020-01-26T00:00:00Z"} {
+		if re.MatchString(s) {
+			t.Errorf("date pattern matches %q", s)
+		}
+	}
+}
+
+func TestYearMonthSchema(t *testing.T) {
+	s := YearMonthOpenAPISchema()
+	want := "A month from 1970-01 through 7431-04, inclusive."
+	if s["description"] != want {
+		t.Errorf("description = %q, want %q", s["description"], want)
+	}
+	if _, err := json.Marshal(YearMonthJSONSchema()); err != nil {
+		t.Error(err)
+	}
+
+	re := regexp.MustCompile(yearMonthPattern)
+	f := func(ym YearMonth) bool {
+		b, _ := ym.MarshalText()
+		return re.Match(b)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	if re.MatchString("2020-01-26") {
+		t.Error("year-month pattern matches a date")
+	}
+}
+
+func TestDateRangeSchema(t *testing.T) {
+	s := DateRangeOpenAPISchema()
+	if _, err := json.Marshal(DateRangeJSONSchema()); err != nil {
+		t.Error(err)
+	}
+
+	re := regexp.MustCompile(dateRangePattern)
+	f := func(r DateRange) bool {
+		b, _ := r.MarshalText()
+		return re.Match(b) && len(b) >= s["minLength"].(int) && len(b) <= s["maxLength"].(int)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	for _, s := range []string{"2020-01-26", "2020-01-26/", "/2020-01-26", "2020-01-26/.", "2020-01-26..2020-12-31", "2020-01-26/2020-13-01"} {
+		if re.MatchString(s) {
+			t.Errorf("date range pattern matches %q", s)
+		}
+	}
+}
+
+func TestPeriodSchema(t *testing.T) {
+	if _, err := json.Marshal(PeriodJSONSchema()); err != nil {
+		t.Error(err)
+	}
+
+	re := regexp.MustCompile(periodPattern)
+	f := func(p Period) bool {
+		b, _ := p.MarshalText()
+		return re.Match(b)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+	for _, s := range []string{"P1Y-2M3W4D", "-P1M", "+P0D", "P3W"} {
+		if _, err := ParsePeriod(s); err != nil || !re.MatchString(s) {
+			t.Errorf("period pattern does not match %q, which parses with error %v", s, err)
+		}
+	}
+	for _, s := range []string{"P", "-P", "1Y", "P1D2M", "PT12H", "P1Y2M3M", "P1.5D"} {
+		if re.MatchString(s) {
+			t.Errorf("period pattern matches %q", s)
+		}
+	}
+}
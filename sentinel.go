@@ -0,0 +1,107 @@
+package epochdate
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// SentinelFormat formats and parses dates, writing the minimum and maximum
+// Dates as sentinel strings. Since out-of-range dates are clamped to these
+// extremes, they often mean "unbounded", as in the ends of open-ended
+// validity windows, which read better as "-inf" and "+inf", or as empty
+// strings, than as 1970-01-01 and 2149-06-06.
+//
+// Min and Max should differ, and should not be parseable as dates. To write
+// only one extreme as a sentinel, set the other to its date, such as
+// "1970-01-01".
+//
+type SentinelFormat struct {
+	Min, Max string
+}
+
+// InfSentinels writes the extremes as "-inf" and "+inf". It is the format
+// used by SentinelDate.
+//
+var InfSentinels = SentinelFormat{Min: "-inf", Max: "+inf"}
+
+// Format returns f.Min or f.Max if d is the minimum or maximum Date,
+// respectively, or else d in RFC 3339 form.
+//
+func (f SentinelFormat) Format(d Date) string {
+	switch {
+	case d.IsMin():
+		return f.Min
+	case d.IsMax():
+		return f.Max
+	}
+	return d.String()
+}
+
+// Parse returns the minimum or maximum Date if value is f.Min or f.Max,
+// respectively, or else parses value as an RFC 3339 date.
+//
+func (f SentinelFormat) Parse(value string) (Date, error) {
+	switch value {
+	case f.Min:
+		return 0, nil
+	case f.Max:
+		return maxDate, nil
+	}
+	return ParseRFC(value)
+}
+
+// SentinelDate is a Date which is marshaled and unmarshaled using
+// InfSentinels, so that the extremes are written as "-inf" and "+inf":
+//
+//	type Price struct {
+//		ValidFrom  epochdate.SentinelDate `json:"valid_from"`  // "-inf"
+//		ValidUntil epochdate.SentinelDate `json:"valid_until"` // "2025-12-31"
+//	}
+//
+// Other formats may be used by calling SentinelFormat.Format and Parse from
+// the methods of a similar type.
+//
+type SentinelDate Date
+
+// Date returns s as a Date.
+func (s SentinelDate) Date() Date {
+	return Date(s)
+}
+
+// String returns s formatted using InfSentinels.
+func (s SentinelDate) String() string {
+	return InfSentinels.Format(Date(s))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SentinelDate) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the
+// sentinels of InfSentinels as well as RFC 3339 dates.
+//
+func (s *SentinelDate) UnmarshalText(data []byte) error {
+	d, err := InfSentinels.Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*s = SentinelDate(d)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (s SentinelDate) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(s.String())), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. As with Date, null leaves s
+// unchanged.
+//
+func (s *SentinelDate) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	data = bytes.Trim(data, `"`)
+	return s.UnmarshalText(data)
+}
@@ -0,0 +1,56 @@
+package epochdate
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSentinelFormat(t *testing.T) {
+	empty := SentinelFormat{Min: "1970-01-01", Max: ""}
+	tests := []struct {
+		f    SentinelFormat
+		d    Date
+		want string
+	}{
+		{InfSentinels, 0, "-inf"},
+		{InfSentinels, maxDate, "+inf"},
+		{InfSentinels, MustParseRFC("2024-03-01"), "2024-03-01"},
+		{empty, 0, "1970-01-01"},
+		{empty, maxDate, ""},
+	}
+	for _, test := range tests {
+		s := test.f.Format(test.d)
+		if s != test.want {
+			t.Errorf("%+v.Format(%d) = %q; want %q", test.f, test.d, s, test.want)
+		}
+		if d, err := test.f.Parse(s); err != nil || d != test.d {
+			t.Errorf("%+v.Parse(%q) = %d, %v; want %d", test.f, s, d, err, test.d)
+		}
+	}
+	if _, err := InfSentinels.Parse("inf"); err == nil {
+		t.Error("Parse(inf) succeeded")
+	}
+}
+
+func TestSentinelDate_JSON(t *testing.T) {
+	type window struct {
+		From  SentinelDate `json:"from"`
+		Until SentinelDate `json:"until"`
+	}
+	w := window{From: 0, Until: SentinelDate(MustParseRFC("2025-12-31"))}
+	data, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"from":"-inf","until":"2025-12-31"}`; string(data) != want {
+		t.Errorf("Marshal = %s; want %s", data, want)
+	}
+
+	var got window
+	if err := json.Unmarshal([]byte(`{"from":"2024-01-01","until":"+inf"}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.From.Date() != MustParseRFC("2024-01-01") || got.Until.Date() != maxDate {
+		t.Errorf("Unmarshal = %+v", got)
+	}
+}
@@ -0,0 +1,113 @@
+package epochdate
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var errScanNull = errors.New("epochdate: cannot scan NULL into a Date or YearMonth")
+
+// Value implements driver.Valuer, returning midnight UTC on d, which drivers
+// store in DATE columns.
+//
+func (d Date) Value() (driver.Value, error) {
+	return d.UTC(), nil
+}
+
+// Scan implements sql.Scanner. It accepts a time.Time, using its date in its
+// own location, since drivers return DATE columns as midnight in either UTC
+// or the connection's location; an RFC 3339 date as a string or []byte,
+// optionally followed by a time, as some drivers return; or an int64 number
+// of days since 1970-01-01, the representation of Date. NULL is an error.
+//
+func (d *Date) Scan(src interface{}) error {
+	v, err := scanDate(src)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// Value implements driver.Valuer, returning midnight UTC on the first day of
+// ym. It returns an error if ym is beyond the range of Date.
+//
+func (ym YearMonth) Value() (driver.Value, error) {
+	if err := ym.Validate(); err != nil {
+		return nil, err
+	}
+	return ym.StartDate().UTC(), nil
+}
+
+// Scan implements sql.Scanner. It accepts the same values as Date.Scan,
+// with any day of the month, except that a string or []byte may also be a
+// year and month alone, such as "2024-03", and an int64 is a number of
+// months since 1970-01. NULL is an error.
+//
+func (ym *YearMonth) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case int64:
+		if v < 0 || v > maxDate {
+			return errYearMonthOutOfRange
+		}
+		*ym = YearMonth(v)
+		return nil
+
+	case string:
+		if len(v) == len(rfc3339YearMonth) {
+			return ym.UnmarshalText([]byte(v))
+		}
+
+	case []byte:
+		if len(v) == len(rfc3339YearMonth) {
+			return ym.UnmarshalText(v)
+		}
+	}
+	d, err := scanDate(src)
+	if err != nil {
+		return err
+	}
+	*ym = d.YearMonth()
+	return nil
+}
+
+// scanDate converts a value returned by a driver to a Date, as documented
+// by Date.Scan.
+//
+func scanDate(src interface{}) (Date, error) {
+	switch v := src.(type) {
+	case nil:
+		return 0, errScanNull
+
+	case time.Time:
+		return NewFromTime(v)
+
+	case string:
+		return parseColumn(v)
+
+	case []byte:
+		return parseColumn(string(v))
+
+	case int64:
+		if v < 0 || v > maxDate {
+			return 0, ErrOutOfRange
+		}
+		return Date(v), nil
+	}
+	return 0, fmt.Errorf("epochdate: cannot scan %T into a Date", src)
+}
+
+// parseColumn parses a DATE column in text form, which some drivers return
+// with a time component.
+//
+func parseColumn(s string) (Date, error) {
+	if len(s) > len(RFC3339) {
+		switch s[len(RFC3339)] {
+		case 'T', ' ':
+			s = s[:len(RFC3339)]
+		}
+	}
+	return ParseRFC(s)
+}
@@ -0,0 +1,76 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDate_Scan(t *testing.T) {
+	want := MustParseRFC("2024-03-01")
+	tokyo := time.FixedZone("JST", 9*3600)
+	tests := []interface{}{
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, time.March, 1, 0, 0, 0, 0, tokyo),
+		"2024-03-01",
+		"2024-03-01 00:00:00",
+		"2024-03-01T00:00:00Z",
+		[]byte("2024-03-01"),
+		int64(want),
+	}
+	for _, src := range tests {
+		var d Date
+		if err := d.Scan(src); err != nil || d != want {
+			t.Errorf("Scan(%#v) = %v, %v; want %v", src, d, err, want)
+		}
+	}
+
+	for _, src := range []interface{}{nil, "2024-03", "2024-03-01x", int64(-1), int64(maxDate + 1), 1.5} {
+		var d Date
+		if err := d.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) = %v; want error", src, d)
+		}
+	}
+}
+
+func TestDate_Value(t *testing.T) {
+	d := MustParseRFC("2024-03-01")
+	v, err := d.Value()
+	if err != nil || v != time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	var got Date
+	if err := got.Scan(v); err != nil || got != d {
+		t.Errorf("Scan(Value()) = %v, %v; want %v", got, err, d)
+	}
+}
+
+func TestYearMonth_Scan(t *testing.T) {
+	want := MustParseRFC("2024-03-01").YearMonth()
+	tests := []interface{}{
+		time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		"2024-03",
+		"2024-03-15",
+		[]byte("2024-03"),
+		int64(want),
+	}
+	for _, src := range tests {
+		var ym YearMonth
+		if err := ym.Scan(src); err != nil || ym != want {
+			t.Errorf("Scan(%#v) = %v, %v; want %v", src, ym, err, want)
+		}
+	}
+	for _, src := range []interface{}{nil, "2024-13", int64(-1), int64(maxDate + 1)} {
+		var ym YearMonth
+		if err := ym.Scan(src); err == nil {
+			t.Errorf("Scan(%#v) = %v; want error", src, ym)
+		}
+	}
+
+	v, err := want.Value()
+	if err != nil || v != time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("Value() = %v, %v", v, err)
+	}
+	if _, err := YearMonth(maxDate).Value(); err == nil {
+		t.Error("Value() of YearMonth beyond Date succeeded")
+	}
+}
@@ -0,0 +1,123 @@
+package epochdate
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Tags identify the type of a value in the tagged binary encoding produced
+// by AppendTagged. Each encoded value is its tag byte followed by a
+// fixed-size, big-endian payload:
+//
+//	TagDate       2 bytes: the Date
+//	TagDate32     4 bytes: the Date32, in two's complement
+//	TagYearMonth  2 bytes: the YearMonth
+//	TagDateRange  4 bytes: the Start and End Dates of a closed range
+//	TagOpenRange  5 bytes: flags (1 for OpenStart, 2 for OpenEnd), then
+//	              the Start and End Dates of a range with an open bound
+//
+// The flags of TagOpenRange must include at least one of the defined bits,
+// and no others, so that the remaining bits stay free for future use.
+//
+// Tags are never reused or redefined, so a change to a payload would be
+// given a new tag, and data encoded today will always decode to the same
+// value and type.
+//
+const (
+	TagDate      byte = 1
+	TagDate32    byte = 2
+	TagYearMonth byte = 3
+	TagDateRange byte = 4
+	TagOpenRange byte = 5
+)
+
+var (
+	errTaggedShort = errors.New("epochdate: tagged value is truncated")
+	errTaggedFlags = errors.New("epochdate: tagged open range has invalid flags")
+)
+
+// Flags of the TagOpenRange payload.
+const (
+	tagOpenStart byte = 1
+	tagOpenEnd   byte = 2
+)
+
+// AppendTagged appends the tagged binary encoding of v to b and returns the
+// extended buffer. The type of v must be Date, Date32, YearMonth, or
+// DateRange.
+//
+func AppendTagged(b []byte, v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case Date:
+		return append(b, TagDate, byte(v>>8), byte(v)), nil
+	case Date32:
+		b = append(b, TagDate32, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(b[len(b)-4:], uint32(v))
+		return b, nil
+	case YearMonth:
+		return append(b, TagYearMonth, byte(v>>8), byte(v)), nil
+	case DateRange:
+		if !v.OpenStart && !v.OpenEnd {
+			return append(b, TagDateRange, byte(v.Start>>8), byte(v.Start), byte(v.End>>8), byte(v.End)), nil
+		}
+		var flags byte
+		if v.OpenStart {
+			flags |= tagOpenStart
+		}
+		if v.OpenEnd {
+			flags |= tagOpenEnd
+		}
+		return append(b, TagOpenRange, flags, byte(v.Start>>8), byte(v.Start), byte(v.End>>8), byte(v.End)), nil
+	}
+	return b, fmt.Errorf("epochdate: cannot encode %T as a tagged value", v)
+}
+
+// DecodeTagged decodes the first tagged value in b, as encoded by
+// AppendTagged, and returns it along with the number of bytes it occupied,
+// so that a stream of values can be decoded in turn. The dynamic type of
+// the result is determined by the tag. An error is returned if the tag is
+// unknown, the value is truncated, or an open range has unknown or no
+// flags set.
+//
+func DecodeTagged(b []byte) (v interface{}, n int, err error) {
+	if len(b) == 0 {
+		return nil, 0, errTaggedShort
+	}
+	var size int
+	switch b[0] {
+	case TagDate, TagYearMonth:
+		size = 2
+	case TagDate32, TagDateRange:
+		size = 4
+	case TagOpenRange:
+		size = 5
+	default:
+		return nil, 0, fmt.Errorf("epochdate: unknown tag %d in tagged value", b[0])
+	}
+	if len(b) < 1+size {
+		return nil, 0, errTaggedShort
+	}
+	p := b[1 : 1+size]
+	switch b[0] {
+	case TagDate:
+		v = Date(binary.BigEndian.Uint16(p))
+	case TagYearMonth:
+		v = YearMonth(binary.BigEndian.Uint16(p))
+	case TagDate32:
+		v = Date32(int32(binary.BigEndian.Uint32(p)))
+	case TagDateRange:
+		v = DateRange{Start: Date(binary.BigEndian.Uint16(p)), End: Date(binary.BigEndian.Uint16(p[2:]))}
+	case TagOpenRange:
+		if p[0] == 0 || p[0]&^(tagOpenStart|tagOpenEnd) != 0 {
+			return nil, 0, errTaggedFlags
+		}
+		v = DateRange{
+			Start:     Date(binary.BigEndian.Uint16(p[1:])),
+			End:       Date(binary.BigEndian.Uint16(p[3:])),
+			OpenStart: p[0]&tagOpenStart != 0,
+			OpenEnd:   p[0]&tagOpenEnd != 0,
+		}
+	}
+	return v, 1 + size, nil
+}
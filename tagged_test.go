@@ -0,0 +1,60 @@
+package epochdate
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTagged(t *testing.T) {
+	values := []interface{}{
+		Date(19783),
+		Date32(-1),
+		Date32(maxDate + 1),
+		YearMonth(650),
+		DateRange{Start: 10, End: 20},
+		Date(maxDate),
+		RangeFrom(10),
+	}
+	var b []byte
+	for _, v := range values {
+		var err error
+		if b, err = AppendTagged(b, v); err != nil {
+			t.Fatalf("AppendTagged(%v): %v", v, err)
+		}
+	}
+	want := []byte{
+		TagDate, 0x4d, 0x47,
+		TagDate32, 0xff, 0xff, 0xff, 0xff,
+		TagDate32, 0, 1, 0, 0,
+		TagYearMonth, 0x02, 0x8a,
+		TagDateRange, 0, 10, 0, 20,
+		TagDate, 0xff, 0xff,
+		TagOpenRange, 2, 0, 10, 0xff, 0xff,
+	}
+	if !bytes.Equal(b, want) {
+		t.Fatalf("encoding = %x; want %x", b, want)
+	}
+
+	for i, want := range values {
+		v, n, err := DecodeTagged(b)
+		if err != nil || v != want {
+			t.Fatalf("value %d: DecodeTagged = %#v, %v; want %#v", i, v, err, want)
+		}
+		b = b[n:]
+	}
+	if len(b) != 0 {
+		t.Errorf("%d bytes left over", len(b))
+	}
+}
+
+func TestTagged_errors(t *testing.T) {
+	if _, err := AppendTagged(nil, 5); err == nil {
+		t.Error("AppendTagged(int) succeeded")
+	}
+	for _, b := range [][]byte{nil, {0}, {99, 0, 0}, {TagDate, 1}, {TagDateRange, 0, 0, 0},
+		{TagOpenRange, 0, 0, 0, 0xff, 0xff}, {TagOpenRange, 4, 0, 0, 0xff, 0xff}, {TagOpenRange, 0x83, 0, 0, 0xff, 0xff}} {
+		if v, _, err := DecodeTagged(b); err == nil {
+			t.Errorf("DecodeTagged(%x) = %v; want error", b, v)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+//go:build go1.18
+// +build go1.18
+
+package epochdate
+
+import "sort"
+
+// TimelineEntry associates a value with the dates of a non-empty range.
+type TimelineEntry[V any] struct {
+	Range DateRange
+	Value V
+}
+
+// Timeline maps non-overlapping date ranges to values, such as a tax rate or
+// price list which takes effect on one date and is superseded on another.
+// Dates outside every range have no value. The zero value is an empty
+// timeline, ready to use.
+//
+type Timeline[V any] struct {
+	entries []TimelineEntry[V] // sorted and non-overlapping
+}
+
+// Set associates v with every date in r, replacing the values of any
+// overlapping entries, which are truncated or split as necessary. Setting an
+// empty range has no effect.
+//
+func (t *Timeline[V]) Set(r DateRange, v V) {
+	t.replace(r, &TimelineEntry[V]{r, v})
+}
+
+// Delete removes the values of every date in r, truncating or splitting
+// overlapping entries as necessary.
+//
+func (t *Timeline[V]) Delete(r DateRange) {
+	t.replace(r, nil)
+}
+
+// replace removes the dates in r from the timeline, and then inserts e, if
+// it is not nil, in their place.
+//
+func (t *Timeline[V]) replace(r DateRange, e *TimelineEntry[V]) {
+	if r.Len() == 0 {
+		return
+	}
+	// lo is the first entry ending on or after r.Start, and hi is the first
+	// entry starting after r.End, so entries lo through hi-1 overlap r.
+	lo := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].Range.End >= r.Start
+	})
+	hi := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].Range.Start > r.End
+	})
+
+	var mid []TimelineEntry[V]
+	if lo < hi && t.entries[lo].Range.Start < r.Start {
+		left := t.entries[lo]
+		left.Range.End, left.Range.OpenEnd = r.Start-1, false
+		mid = append(mid, left)
+	}
+	if e != nil {
+		mid = append(mid, *e)
+	}
+	if lo < hi && t.entries[hi-1].Range.End > r.End {
+		right := t.entries[hi-1]
+		right.Range.Start, right.Range.OpenStart = r.End+1, false
+		mid = append(mid, right)
+	}
+
+	entries := make([]TimelineEntry[V], 0, len(t.entries)-(hi-lo)+len(mid))
+	entries = append(entries, t.entries[:lo]...)
+	entries = append(entries, mid...)
+	t.entries = append(entries, t.entries[hi:]...)
+}
+
+// At returns the value associated with d, and whether there is one.
+func (t *Timeline[V]) At(d Date) (V, bool) {
+	i := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].Range.End >= d
+	})
+	if i < len(t.entries) && t.entries[i].Range.Start <= d {
+		return t.entries[i].Value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Len returns the number of entries in the timeline.
+func (t *Timeline[V]) Len() int {
+	return len(t.entries)
+}
+
+// Entries returns the entries of the timeline in chronological order.
+// Adjacent entries are not merged, even if their values are equal.
+//
+func (t *Timeline[V]) Entries() []TimelineEntry[V] {
+	return append([]TimelineEntry[V](nil), t.entries...)
+}
+
+// Each calls fn for each entry of the timeline in chronological order,
+// stopping early if fn returns false.
+//
+func (t *Timeline[V]) Each(fn func(TimelineEntry[V]) bool) {
+	for _, e := range t.entries {
+		if !fn(e) {
+			return
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//go:build go1.18
+// +build go1.18
+
+package epochdate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func rng(start, end string) DateRange {
+	return DateRange{Start: MustParseRFC(start), End: MustParseRFC(end)}
+}
+
+func timelineString(t *Timeline[string]) string {
+	var parts []string
+	for _, e := range t.Entries() {
+		parts = append(parts, fmt.Sprintf("%v..%v=%s", e.Range.Start, e.Range.End, e.Value))
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestTimeline(t *testing.T) {
+	var tl Timeline[string]
+	tl.Set(rng("2024-01-01", "2024-12-31"), "a")
+	tl.Set(rng("2025-01-01", "2025-12-31"), "b")
+	tl.Set(rng("2024-06-01", "2024-06-30"), "c")
+	tl.Set(rng("2024-12-01", "2025-01-31"), "d")
+	tl.Set(rng("2026-01-01", "2025-01-01"), "empty")
+
+	want := "2024-01-01..2024-05-31=a 2024-06-01..2024-06-30=c 2024-07-01..2024-11-30=a " +
+		"2024-12-01..2025-01-31=d 2025-02-01..2025-12-31=b"
+	if got := timelineString(&tl); got != want {
+		t.Fatalf("entries = %s\nwant %s", got, want)
+	}
+
+	tests := []struct {
+		date string
+		want string
+		ok   bool
+	}{
+		{"2023-12-31", "", false},
+		{"2024-01-01", "a", true},
+		{"2024-06-15", "c", true},
+		{"2024-07-01", "a", true},
+		{"2025-01-31", "d", true},
+		{"2025-02-01", "b", true},
+		{"2026-01-01", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := tl.At(MustParseRFC(tt.date))
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("At(%s) = %q, %v; want %q, %v", tt.date, got, ok, tt.want, tt.ok)
+		}
+	}
+
+	tl.Delete(rng("2024-03-01", "2025-06-30"))
+	want = "2024-01-01..2024-02-29=a 2025-07-01..2025-12-31=b"
+	if got := timelineString(&tl); got != want {
+		t.Errorf("after Delete, entries = %s\nwant %s", got, want)
+	}
+
+	tl.Set(DateRange{Start: 0, End: maxDate}, "all")
+	if tl.Len() != 1 {
+		t.Errorf("after covering Set, entries = %s", timelineString(&tl))
+	}
+
+	n := 0
+	tl.Each(func(TimelineEntry[string]) bool {
+		n++
+		return false
+	})
+	if n != 1 {
+		t.Errorf("Each called fn %d times; want 1", n)
+	}
+}
+
+func TestTimeline_splitOpenEntry(t *testing.T) {
+	var tl Timeline[int]
+	tl.Set(RangeFrom(MustParseRFC("2024-01-01")), 1)
+	tl.Set(rng("2024-03-01", "2024-03-31"), 2)
+
+	var got []string
+	for _, e := range tl.Entries() {
+		got = append(got, e.Range.String())
+	}
+	want := []string{"2024-01-01/2024-02-29", "2024-03-01/2024-03-31", "2024-04-01/.."}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("entries = %q; want %q", got, want)
+	}
+
+	tl = Timeline[int]{}
+	tl.Set(RangeThrough(MustParseRFC("2024-12-31")), 1)
+	tl.Set(rng("2024-03-01", "2024-03-31"), 2)
+	if first := tl.Entries()[0].Range; !first.OpenStart || first.OpenEnd {
+		t.Errorf("left piece = %+v; want open start only", first)
+	}
+	if last := tl.Entries()[2].Range; last.OpenStart || last.OpenEnd {
+		t.Errorf("right piece = %+v; want closed", last)
+	}
+}
@@ -0,0 +1,125 @@
+// Package validatedate integrates epochdate with the go-playground
+// validator, so that request structs can declare date constraints:
+//
+//	type Booking struct {
+//		CheckIn  epochdate.Date      `validate:"required,dateafter=today"`
+//		CheckOut epochdate.Date      `validate:"required,gtfield=CheckIn"`
+//		Season   epochdate.YearMonth `validate:"daterange=2024-01 2024-12"`
+//	}
+//
+//	v := validator.New()
+//	if err := validatedate.Register(v); err != nil {
+//		...
+//	}
+//
+// Register makes Date and YearMonth fields validate as the time.Time at the
+// start of the date or month in UTC, so that built-in tags such as
+// required, gtfield, and ltefield work as they do for time.Time fields. The
+// zero Date and YearMonth are treated as the zero time.Time, so required and
+// omitempty treat them as unset, consistent with their IsZero methods.
+//
+// Register also adds the following tags, whose parameters are an RFC 3339
+// date (2006-01-02), a month (2006-01, meaning its first day), or "today"
+// (the current UTC date, according to epochdate.DefaultClock):
+//
+//	datebefore=param   the date is before param
+//	dateafter=param    the date is after param
+//	daterange=lo hi    the date is within lo through hi, inclusive
+//
+// The tags may also be used on time.Time fields, comparing the date of the
+// time in its own location. As with the built-in tags, invalid parameters
+// cause a panic when a field is validated.
+//
+package validatedate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/xtgo/epochdate"
+)
+
+// Register registers the Date and YearMonth types, and the date comparison
+// tags, with v.
+//
+func Register(v *validator.Validate) error {
+	v.RegisterCustomTypeFunc(toTime, epochdate.Date(0), epochdate.YearMonth(0))
+	for tag, fn := range tags {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tags holds the validation functions registered by Register.
+var tags = map[string]validator.Func{
+	"datebefore": func(fl validator.FieldLevel) bool {
+		d, ok := fieldDate(fl.Field())
+		return ok && d < paramDate(fl.Param())
+	},
+	"dateafter": func(fl validator.FieldLevel) bool {
+		d, ok := fieldDate(fl.Field())
+		return ok && d > paramDate(fl.Param())
+	},
+	"daterange": func(fl validator.FieldLevel) bool {
+		params := strings.Fields(fl.Param())
+		if len(params) != 2 {
+			panic(fmt.Sprintf("validatedate: daterange requires two dates, got %q", fl.Param()))
+		}
+		d, ok := fieldDate(fl.Field())
+		return ok && paramDate(params[0]) <= d && d <= paramDate(params[1])
+	},
+}
+
+// toTime implements validator.CustomTypeFunc.
+func toTime(v reflect.Value) interface{} {
+	switch x := v.Interface().(type) {
+	case epochdate.Date:
+		if x.IsZero() {
+			return time.Time{}
+		}
+		return x.UTC()
+
+	case epochdate.YearMonth:
+		if x.IsZero() {
+			return time.Time{}
+		}
+		return x.StartTime(time.UTC)
+	}
+	return nil
+}
+
+// fieldDate returns the date of a field value, as converted by toTime.
+// The zero time.Time yields the zero Date.
+//
+func fieldDate(v reflect.Value) (epochdate.Date, bool) {
+	t, ok := v.Interface().(time.Time)
+	if !ok {
+		return 0, false
+	}
+	if t.IsZero() {
+		return 0, true
+	}
+	d, err := epochdate.NewFromTime(t)
+	return d, err == nil
+}
+
+// paramDate parses a tag parameter, panicking if it is invalid.
+func paramDate(s string) epochdate.Date {
+	if s == "today" {
+		return epochdate.TodayUTC()
+	}
+	d, err := epochdate.ParseRFC(s)
+	if err != nil {
+		var ym epochdate.YearMonth
+		if ym.UnmarshalText([]byte(s)) != nil || ym.Validate() != nil {
+			panic(fmt.Sprintf("validatedate: invalid date parameter %q", s))
+		}
+		d = ym.StartDate()
+	}
+	return d
+}
@@ -0,0 +1,91 @@
+package validatedate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/xtgo/epochdate"
+)
+
+type booking struct {
+	CheckIn  epochdate.Date      `validate:"required,dateafter=2024-01-31"`
+	CheckOut epochdate.Date      `validate:"required,gtfield=CheckIn,datebefore=2025-01"`
+	Season   epochdate.YearMonth `validate:"omitempty,daterange=2024-01 2024-12"`
+}
+
+func TestRegister(t *testing.T) {
+	v := validator.New()
+	if err := Register(v); err != nil {
+		t.Fatal(err)
+	}
+
+	d := epochdate.MustParseRFC
+	ym := func(s string) epochdate.YearMonth { return d(s).YearMonth() }
+	tests := []struct {
+		name    string
+		b       booking
+		invalid []string // tags of failing fields, in field order
+	}{
+		{"valid", booking{d("2024-02-01"), d("2024-02-05"), ym("2024-02-01")}, nil},
+		{"unset_season", booking{d("2024-02-01"), d("2024-12-31"), 0}, nil},
+		{"missing", booking{}, []string{"required", "required"}},
+		{"early", booking{d("2024-01-31"), d("2024-02-05"), 0}, []string{"dateafter"}},
+		{"reversed", booking{d("2024-02-05"), d("2024-02-05"), 0}, []string{"gtfield"}},
+		{"late", booking{d("2024-02-01"), d("2025-01-01"), 0}, []string{"datebefore"}},
+		{"season", booking{d("2024-02-01"), d("2024-02-05"), ym("2025-01-01")}, []string{"daterange"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.Struct(tt.b)
+			var verrs validator.ValidationErrors
+			if err != nil && !errors.As(err, &verrs) {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, fe := range verrs {
+				got = append(got, fe.Tag())
+			}
+			if len(got) != len(tt.invalid) {
+				t.Fatalf("failed tags = %q, want %q", got, tt.invalid)
+			}
+			for i := range got {
+				if got[i] != tt.invalid[i] {
+					t.Errorf("failed tags = %q, want %q", got, tt.invalid)
+				}
+			}
+		})
+	}
+}
+
+func TestToday(t *testing.T) {
+	defer func(c epochdate.Clock) { epochdate.DefaultClock = c }(epochdate.DefaultClock)
+	epochdate.DefaultClock = epochdate.ClockFunc(func() time.Time {
+		return time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	v := validator.New()
+	if err := Register(v); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.Var(epochdate.MustParseRFC("2024-03-02"), "dateafter=today"); err != nil {
+		t.Error(err)
+	}
+	if err := v.Var(epochdate.MustParseRFC("2024-03-01"), "dateafter=today"); err == nil {
+		t.Error("today is after today")
+	}
+}
+
+func TestInvalidParam(t *testing.T) {
+	v := validator.New()
+	if err := Register(v); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("no panic for invalid parameter")
+		}
+	}()
+	v.Var(epochdate.Date(1), "datebefore=tomorrow")
+}
@@ -0,0 +1,34 @@
+package epochdate
+
+import "time"
+
+// Weekday returns the day of the week of d, as time.Time.Weekday does.
+func (d Date) Weekday() time.Weekday {
+	// 1970-01-01 was a Thursday.
+	return time.Weekday((int(d) + int(time.Thursday)) % 7)
+}
+
+// CountWeekdays returns the number of dates in r which fall on w.
+func CountWeekdays(r DateRange, w time.Weekday) int {
+	n := r.Len()
+	if n == 0 {
+		return 0
+	}
+	count := n / 7
+	if (int(w)-int(r.Start.Weekday())+7)%7 < n%7 {
+		count++
+	}
+	return count
+}
+
+// WeekdayOccurrences returns the dates in r which fall on w, in order.
+func WeekdayOccurrences(r DateRange, w time.Weekday) []Date {
+	dates := make([]Date, 0, CountWeekdays(r, w))
+	if r.Len() == 0 {
+		return dates
+	}
+	for d := int(r.Start) + (int(w)-int(r.Start.Weekday())+7)%7; d <= int(r.End); d += 7 {
+		dates = append(dates, Date(d))
+	}
+	return dates
+}
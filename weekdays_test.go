@@ -0,0 +1,62 @@
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+func TestCountWeekdays(t *testing.T) {
+	march := DateRange{Start: MustParseRFC("2024-03-01"), End: MustParseRFC("2024-03-31")}
+	tests := []struct {
+		r    DateRange
+		w    time.Weekday
+		want int
+	}{
+		{march, time.Friday, 5},
+		{march, time.Saturday, 5},
+		{march, time.Sunday, 5},
+		{march, time.Monday, 4},
+		{march, time.Thursday, 4},
+		{DateRange{Start: march.Start, End: march.Start}, time.Friday, 1},
+		{DateRange{Start: march.Start, End: march.Start}, time.Monday, 0},
+		{DateRange{Start: march.End, End: march.Start}, time.Friday, 0},
+		{DateRange{Start: 0, End: maxDate}, time.Thursday, 9363},
+	}
+	for _, tt := range tests {
+		if got := CountWeekdays(tt.r, tt.w); got != tt.want {
+			t.Errorf("CountWeekdays(%v, %v) = %d, want %d", tt.r, tt.w, got, tt.want)
+		}
+	}
+
+	if got := WeekdayOccurrences(march, time.Monday); len(got) != 4 || got[0].String() != "2024-03-04" || got[3].String() != "2024-03-25" {
+		t.Errorf("WeekdayOccurrences(march, Monday) = %v", got)
+	}
+	if got := WeekdayOccurrences(DateRange{Start: maxDate - 3, End: maxDate}, time.Friday); len(got) != 1 || got[0] != maxDate {
+		t.Errorf("WeekdayOccurrences at the end of the range = %v", got)
+	}
+
+	f := func(a, b Date, w uint8) bool {
+		wd := time.Weekday(w % 7)
+		if a > b {
+			a, b = b, a
+		}
+		if b-a > 1000 {
+			b = a + 1000
+		}
+		r := DateRange{Start: a, End: b}
+		dates := WeekdayOccurrences(r, wd)
+		if len(dates) != CountWeekdays(r, wd) {
+			return false
+		}
+		for _, d := range dates {
+			if d < a || d > b || d.UTC().Weekday() != wd {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
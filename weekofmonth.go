@@ -0,0 +1,60 @@
+package epochdate
+
+import (
+	"errors"
+	"time"
+)
+
+var errNthWeekday = errors.New("epochdate: no such weekday instance in the month")
+
+// WeekOfMonth returns the week of the month in which d occurs, for weeks
+// beginning on weekStart. The week containing the first day of the month
+// is week 1, so a month spans 4 to 6 weeks, as when drawn as a calendar.
+//
+func (d Date) WeekOfMonth(weekStart time.Weekday) int {
+	t := d.UTC()
+	first := t.AddDate(0, 0, 1-t.Day())
+	offset := (int(first.Weekday()) - int(weekStart) + 7) % 7
+	return (t.Day()-1+offset)/7 + 1
+}
+
+// WeekdayInstance reports which instance of its weekday d is within its
+// month, such that n is 2 for the second Tuesday of a month, and last is
+// true if d is the last such weekday of the month, as for rules like "last
+// Friday".
+//
+func (d Date) WeekdayInstance() (n int, last bool) {
+	year, month, day := d.Date()
+	return (day-1)/7 + 1, day+7 > daysIn(year, month)
+}
+
+// NthWeekday returns the nth instance of weekday in the given month, such
+// as the fourth Thursday of November for n = 4. Negative n count from the
+// end of the month, so that -1 yields the last instance. It returns an
+// error if the month has no such instance, as for a fifth Monday in most
+// months, or n = 0. Out-of-range dates are clamped or yield ErrOutOfRange,
+// according to Clamp.
+//
+func NthWeekday(year int, month time.Month, weekday time.Weekday, n int) (Date, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return 0, errISOWeekday
+	}
+	// Normalize the month, as time.Date does.
+	t := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	year, month = t.Year(), t.Month()
+	days := daysIn(year, month)
+
+	var day int
+	switch {
+	case n > 0:
+		day = 1 + (int(weekday)-int(t.Weekday())+7)%7 + 7*(n-1)
+
+	case n < 0:
+		lastWeekday := time.Weekday((int(t.Weekday()) + days - 1) % 7)
+		day = days - (int(lastWeekday)-int(weekday)+7)%7 + 7*(n+1)
+	}
+	if day < 1 || day > days {
+		return 0, errNthWeekday
+	}
+	return NewFromDate(year, month, day)
+}
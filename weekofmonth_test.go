@@ -0,0 +1,103 @@
+package epochdate
+
+import (
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+func TestWeekOfMonth(t *testing.T) {
+	tests := []struct {
+		date      string
+		weekStart time.Weekday
+		want      int
+	}{
+		// March 2024 begins on a Friday.
+		{"2024-03-01", time.Sunday, 1},
+		{"2024-03-02", time.Sunday, 1},
+		{"2024-03-03", time.Sunday, 2},
+		{"2024-03-03", time.Monday, 1},
+		{"2024-03-04", time.Monday, 2},
+		{"2024-03-31", time.Sunday, 6},
+		{"2024-03-31", time.Monday, 5},
+		{"2024-03-01", time.Friday, 1},
+		{"2024-03-08", time.Friday, 2},
+		// February 2015 begins on a Sunday, and spans exactly 4 weeks.
+		{"2015-02-28", time.Sunday, 4},
+	}
+	for _, tt := range tests {
+		if got := MustParseRFC(tt.date).WeekOfMonth(tt.weekStart); got != tt.want {
+			t.Errorf("%s.WeekOfMonth(%v) = %d, want %d", tt.date, tt.weekStart, got, tt.want)
+		}
+	}
+}
+
+func TestWeekdayInstance(t *testing.T) {
+	tests := []struct {
+		date string
+		n    int
+		last bool
+	}{
+		{"2024-03-01", 1, false},
+		{"2024-03-12", 2, false}, // second Tuesday
+		{"2024-03-29", 5, true},  // fifth and last Friday
+		{"2024-03-25", 4, true},  // fourth and last Monday
+		{"2024-02-22", 4, false}, // fourth Thursday, followed by Feb 29
+		{"2024-02-29", 5, true},
+	}
+	for _, tt := range tests {
+		n, last := MustParseRFC(tt.date).WeekdayInstance()
+		if n != tt.n || last != tt.last {
+			t.Errorf("%s.WeekdayInstance() = %d, %v; want %d, %v", tt.date, n, last, tt.n, tt.last)
+		}
+	}
+}
+
+func TestNthWeekday(t *testing.T) {
+	tests := []struct {
+		year    int
+		month   time.Month
+		weekday time.Weekday
+		n       int
+		want    string
+	}{
+		{2024, time.November, time.Thursday, 4, "2024-11-28"},
+		{2024, time.May, time.Monday, -1, "2024-05-27"},
+		{2024, time.March, time.Friday, 5, "2024-03-29"},
+		{2024, time.March, time.Friday, -5, "2024-03-01"},
+		{2024, time.March, time.Tuesday, 2, "2024-03-12"},
+		{2024, time.March, time.Sunday, -1, "2024-03-31"},
+		{2023, time.December + 3, time.Monday, 3, "2024-03-18"},
+		{2149, time.June, time.Friday, 1, "2149-06-06"},
+	}
+	for _, tt := range tests {
+		d, err := NthWeekday(tt.year, tt.month, tt.weekday, tt.n)
+		if err != nil || d.String() != tt.want {
+			t.Errorf("NthWeekday(%d, %v, %v, %d) = %v, %v; want %s", tt.year, tt.month, tt.weekday, tt.n, d, err, tt.want)
+		}
+	}
+
+	for _, n := range []int{0, 5, -5, 6} {
+		if d, err := NthWeekday(2024, time.March, time.Monday, n); err == nil {
+			t.Errorf("NthWeekday(2024, March, Monday, %d) = %v, want error", n, d)
+		}
+	}
+	if d, err := NthWeekday(2149, time.June, time.Friday, -1); err == nil {
+		t.Errorf("NthWeekday beyond the range = %v, want error", d)
+	}
+
+	f := func(d Date) bool {
+		year, month, _ := d.Date()
+		wd := d.UTC().Weekday()
+		n, last := d.WeekdayInstance()
+		d1, err1 := NthWeekday(year, month, wd, n)
+		if last {
+			d2, err2 := NthWeekday(year, month, wd, -1)
+			return err1 == nil && d1 == d && err2 == nil && d2 == d
+		}
+		return err1 == nil && d1 == d
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
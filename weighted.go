@@ -0,0 +1,141 @@
+package epochdate
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+var (
+	errSamplerRange  = errors.New("epochdate: DateSampler min must not be after max")
+	errSamplerWeight = errors.New("epochdate: DateSampler weights must be finite and non-negative, and not all zero")
+)
+
+// WeightFunc returns the relative likelihood of sampling d. Weights must be
+// finite and non-negative; a date with zero weight is never sampled.
+//
+type WeightFunc func(d Date) float64
+
+// WeekdayWeights returns a WeightFunc weighting dates by their day of the
+// week, indexed by time.Weekday, such as to make weekends quieter than
+// weekdays in a synthetic dataset.
+//
+func WeekdayWeights(weights [7]float64) WeightFunc {
+	return func(d Date) float64 {
+		return weights[d.Weekday()]
+	}
+}
+
+// MonthWeights returns a WeightFunc weighting dates by their month, with
+// January at index 0.
+//
+func MonthWeights(weights [12]float64) WeightFunc {
+	return func(d Date) float64 {
+		_, month, _ := d.Date()
+		return weights[month-1]
+	}
+}
+
+// Seasonal returns a WeightFunc which varies smoothly over each year,
+// following a cosine curve which peaks on the given day of the year (as
+// returned by time.Time.YearDay), with weights ranging from 1-amplitude to
+// 1+amplitude. The amplitude should be in the range [0,1].
+//
+func Seasonal(amplitude float64, peakYearDay int) WeightFunc {
+	return func(d Date) float64 {
+		phase := 2 * math.Pi * float64(d.UTC().YearDay()-peakYearDay) / 365.25
+		return 1 + amplitude*math.Cos(phase)
+	}
+}
+
+// ProductWeights returns a WeightFunc which multiplies the weights of fns,
+// such as to combine weekday and seasonal patterns.
+//
+func ProductWeights(fns ...WeightFunc) WeightFunc {
+	return func(d Date) float64 {
+		w := 1.0
+		for _, fn := range fns {
+			w *= fn(d)
+		}
+		return w
+	}
+}
+
+// DateSampler samples dates from a range according to a WeightFunc. The
+// weights are computed once, when the sampler is created, so that sampling
+// is fast regardless of the cost of the WeightFunc. A DateSampler may be
+// used concurrently if its randomness sources are.
+//
+type DateSampler struct {
+	min Date
+	cum []float64 // cumulative weights
+}
+
+// NewDateSampler returns a sampler of the dates in [min, max], weighted by
+// weight. It returns an error if min is after max, or if any weight is
+// negative or not finite, or every weight is zero.
+//
+func NewDateSampler(min, max Date, weight WeightFunc) (*DateSampler, error) {
+	if min > max {
+		return nil, errSamplerRange
+	}
+	cum := make([]float64, 0, int(max-min)+1)
+	total := 0.0
+	for d := int(min); d <= int(max); d++ {
+		w := weight(Date(d))
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, errSamplerWeight
+		}
+		total += w
+		cum = append(cum, total)
+	}
+	if total == 0 || math.IsInf(total, 0) {
+		return nil, errSamplerWeight
+	}
+	return &DateSampler{min: min, cum: cum}, nil
+}
+
+// Sample returns a date chosen according to the sampler's weights, using r
+// as the source of randomness, or the default source of the math/rand
+// package if r is nil.
+//
+func (s *DateSampler) Sample(r *rand.Rand) Date {
+	var f float64
+	if r == nil {
+		f = rand.Float64()
+	} else {
+		f = r.Float64()
+	}
+	x := f * s.cum[len(s.cum)-1]
+	i := sort.Search(len(s.cum), func(i int) bool { return s.cum[i] > x })
+	if i == len(s.cum) {
+		// Guard against rounding; the last date has non-zero weight.
+		i = len(s.cum) - 1
+		for i > 0 && s.cum[i] == s.cum[i-1] {
+			i--
+		}
+	}
+	return s.min + Date(i)
+}
+
+// StratifiedDates returns n dates in ascending order, one chosen uniformly
+// from each of n consecutive, equally sized strata of [min, max], so that
+// the dates cover the range more evenly than independent samples would.
+// Strata are rounded to whole days, and the same date may be returned more
+// than once if n exceeds the number of days in the range. The randomness
+// source is determined as with RandomDate. It panics if min is after max.
+//
+func StratifiedDates(r *rand.Rand, min, max Date, n int) []Date {
+	if min > max {
+		panic("epochdate: StratifiedDates called with min after max")
+	}
+	dates := make([]Date, n)
+	days := int(max-min) + 1
+	for i := range dates {
+		lo := min + Date(i*days/n)
+		hi := min + Date(((i+1)*days-1)/n)
+		dates[i] = RandomDate(r, lo, hi)
+	}
+	return dates
+}
@@ -0,0 +1,90 @@
+package epochdate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestDateSampler(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	start := MustParseRFC("2024-01-01") // a Monday
+	end := start + 27
+
+	// Sample only Mondays and Wednesdays, with Wednesdays three times as
+	// likely.
+	var weights [7]float64
+	weights[time.Monday] = 1
+	weights[time.Wednesday] = 3
+	s, err := NewDateSampler(start, end, WeekdayWeights(weights))
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := make(map[time.Weekday]int)
+	const n = 20000
+	for i := 0; i < n; i++ {
+		d := s.Sample(r)
+		if d < start || d > end {
+			t.Fatalf("Sample() = %v, out of range", d)
+		}
+		counts[d.UTC().Weekday()]++
+	}
+	if len(counts) != 2 {
+		t.Fatalf("sampled weekdays %v; want only Monday and Wednesday", counts)
+	}
+	if frac := float64(counts[time.Wednesday]) / n; math.Abs(frac-0.75) > 0.02 {
+		t.Errorf("Wednesday fraction = %.3f; want about 0.75", frac)
+	}
+
+	one, err := NewDateSampler(maxDate, maxDate, ProductWeights(Seasonal(0.5, 1), MonthWeights([12]float64{5: 2})))
+	if err != nil || one.Sample(nil) != maxDate {
+		t.Errorf("single-date sampler = %v", err)
+	}
+}
+
+func TestNewDateSampler_errors(t *testing.T) {
+	uniform := func(Date) float64 { return 1 }
+	tests := []struct {
+		name     string
+		min, max Date
+		weight   WeightFunc
+	}{
+		{"range", 10, 9, uniform},
+		{"zero", 0, 10, func(Date) float64 { return 0 }},
+		{"negative", 0, 10, func(Date) float64 { return -1 }},
+		{"NaN", 0, 10, func(Date) float64 { return math.NaN() }},
+		{"Inf", 0, 10, func(Date) float64 { return math.Inf(1) }},
+	}
+	for _, tt := range tests {
+		if _, err := NewDateSampler(tt.min, tt.max, tt.weight); err == nil {
+			t.Errorf("%s: NewDateSampler succeeded", tt.name)
+		}
+	}
+}
+
+func TestSeasonal(t *testing.T) {
+	w := Seasonal(0.5, 182)
+	peak, trough := w(MustParseRFC("2023-07-01")), w(MustParseRFC("2023-01-01"))
+	if math.Abs(peak-1.5) > 1e-9 || math.Abs(trough-0.5) > 0.01 {
+		t.Errorf("Seasonal weights = %v at peak, %v at trough", peak, trough)
+	}
+}
+
+func TestStratifiedDates(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	dates := StratifiedDates(r, 100, 199, 10)
+	if len(dates) != 10 {
+		t.Fatalf("got %d dates", len(dates))
+	}
+	for i, d := range dates {
+		if lo := Date(100 + 10*i); d < lo || d > lo+9 {
+			t.Errorf("date %d = %v, outside stratum [%v, %v]", i, d, lo, lo+9)
+		}
+	}
+	for _, d := range StratifiedDates(r, 5, 6, 5) {
+		if d < 5 || d > 6 {
+			t.Errorf("date %v outside [5, 6]", d)
+		}
+	}
+}
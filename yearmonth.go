@@ -1,6 +1,7 @@
 package epochdate
 
 import (
+	"bytes"
 	"errors"
 	"time"
 )
@@ -18,7 +19,75 @@ func ClampYearMonth(year int, month time.Month) YearMonth {
 	return ym
 }
 
-var errYearMonthOutOfRange = errors.New("epochdate: YearMonth input must be in range [1970-01,TODO]")
+// NewYearMonth returns a YearMonth from its constituent year and month
+// parts, or an error if the result is out of the representable range,
+// 1970-01 through 7431-04. Months outside [1,12] are normalized, as by
+// time.Date, so that month 13 is January of the following year. Unlike the
+// NewFrom* functions, it does not depend on Clamp.
+//
+func NewYearMonth(year int, month time.Month) (YearMonth, error) {
+	ym, err := newYearMonth(year, month)
+	if err != nil {
+		return 0, err
+	}
+	return ym, nil
+}
+
+// YearMonthFromTime returns the YearMonth of t in its location, or an error
+// if it is out of the representable range.
+//
+func YearMonthFromTime(t time.Time) (YearMonth, error) {
+	return NewYearMonth(t.Year(), t.Month())
+}
+
+// ParseYearMonth parses a YearMonth in one of the forms year-month
+// ("2020-01") or year-month-day ("2020-01-01"). If using the year-month-day
+// form, the day is validated but then discarded. An error is returned if the
+// input is invalid or out of range.
+//
+func ParseYearMonth(s string) (YearMonth, error) {
+	t, err := time.Parse(RFC3339, s)
+	if err != nil {
+		t, err = time.Parse(rfc3339YearMonth, s)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return YearMonthFromTime(t)
+}
+
+// MustParseYearMonth is like ParseYearMonth, except that it panics on
+// error.
+//
+func MustParseYearMonth(s string) YearMonth {
+	ym, err := ParseYearMonth(s)
+	if err != nil {
+		panic(err)
+	}
+	return ym
+}
+
+// ThisMonth returns the local month at this instant, according to
+// DefaultClock. If the month is not representable, the zero value is
+// returned (1970-01).
+//
+func ThisMonth() YearMonth {
+	ym, _ := YearMonthFromTime(DefaultClock.Now().Local())
+	return ym
+}
+
+// ThisMonthUTC returns the month at this instant according to DefaultClock,
+// relative to UTC. If the month is not representable, the zero value is
+// returned (1970-01).
+//
+func ThisMonthUTC() YearMonth {
+	ym, _ := YearMonthFromTime(DefaultClock.Now().UTC())
+	return ym
+}
+
+var errYearMonthOutOfRange = errors.New("epochdate: YearMonth input must be in range [1970-01,7431-04]")
+
+var errYearMonthIncompatible = errors.New("epochdate: YearMonth must be in range [1970-01,2149-06] to be compatible with Date")
 
 func newYearMonth(year int, month time.Month) (YearMonth, error) {
 	ym := 12*(year-minYear) + int(month-1)
@@ -58,14 +127,24 @@ func (ym YearMonth) IsMax() bool {
 	return ym == maxDate
 }
 
+// Validate returns an error if the receiver is beyond the range of months
+// which contain a representable Date, 1970-01 through 2149-06 inclusive.
+// Such values may be held and formatted, but StartDate and EndDate clamp
+// them to the maximum Date.
+//
+func (ym YearMonth) Validate() error {
+	if ym > Date(maxDate).YearMonth() {
+		return errYearMonthIncompatible
+	}
+	return nil
+}
+
 // StartTime returns the first inclusive time instant covered by the
 // receiver, relative to the given location, i.e. the zeroth nanosecond of
 // the first day of the month.
 //
 func (ym YearMonth) StartTime(loc *time.Location) time.Time {
-	y := int(minYear + ym/12)
-	m := time.Month(ym%12 + 1)
-	return time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	return time.Date(ym.Year(), ym.Month(), 1, 0, 0, 0, 0, loc)
 }
 
 // EndTime returns the last inclusive time instant (last nanosecond) covered
@@ -122,19 +201,81 @@ func (ym YearMonth) MarshalText() ([]byte, error) {
 // An error will be returned if the input is out of range.
 //
 func (ym *YearMonth) UnmarshalText(b []byte) error {
-	s := string(b)
-	t, err := time.Parse(RFC3339, s)
-	if err != nil {
-		t, err = time.Parse(rfc3339YearMonth, s)
-	}
-	if err != nil {
-		return err
-	}
-	y, m, _ := t.Date()
-	v, err := newYearMonth(y, m)
+	v, err := ParseYearMonth(string(b))
 	if err != nil {
 		return err
 	}
 	*ym = v
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler, using the form returned by
+// MarshalText.
+//
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	return ym.StartTime(time.UTC).AppendFormat([]byte{'"'}, rfc3339YearMonth+`"`), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the forms accepted
+// by UnmarshalText. As with Date, null leaves the receiver unchanged.
+//
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	data = bytes.Trim(data, `"`)
+	return ym.UnmarshalText(data)
+}
+
+// SameMonthLastYear returns the same month of the previous year, such as
+// 2023-03 for 2024-03, or an error if it precedes 1970-01.
+//
+func (ym YearMonth) SameMonthLastYear() (YearMonth, error) {
+	if ym < 12 {
+		return 0, errYearMonthOutOfRange
+	}
+	return ym - 12, nil
+}
+
+// Year returns the year of ym.
+func (ym YearMonth) Year() int {
+	return minYear + int(ym)/12
+}
+
+// Month returns the month of the year of ym.
+func (ym YearMonth) Month() time.Month {
+	return time.Month(ym%12 + 1)
+}
+
+// AddMonths returns the month n months after ym, or before ym if n is
+// negative. Unlike adding to the underlying integer, which silently wraps
+// around, an error is returned if the result is not representable.
+//
+func (ym YearMonth) AddMonths(n int) (YearMonth, error) {
+	// Check n first, so that the sum cannot overflow.
+	if n < -maxDate || n > maxDate || int(ym)+n < 0 || int(ym)+n > maxDate {
+		return 0, errYearMonthOutOfRange
+	}
+	return YearMonth(int(ym) + n), nil
+}
+
+// ClampAddMonths is like AddMonths, except that it saturates at the minimum
+// or maximum YearMonth, rather than returning an error.
+//
+func (ym YearMonth) ClampAddMonths(n int) YearMonth {
+	// Check n first, so that the sum cannot overflow.
+	switch {
+	case n < -maxDate || n < 0 && int(ym)+n < 0:
+		return 0
+	case n > maxDate || int(ym)+n > maxDate:
+		return maxDate
+	}
+	return YearMonth(int(ym) + n)
+}
+
+// Sub returns the number of months from other until ym, which is negative
+// if ym is before other.
+//
+func (ym YearMonth) Sub(other YearMonth) int {
+	return int(ym) - int(other)
+}
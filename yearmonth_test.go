@@ -1,6 +1,8 @@
 package epochdate
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
 	"time"
 )
@@ -362,3 +364,224 @@ func TestYearMonth_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func TestYearMonth_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ym      YearMonth
+		wantErr bool
+	}{
+		{
+			name: "zero",
+			ym:   0,
+		},
+		{
+			name: "last_full_month",
+			ym:   ClampYearMonth(2149, time.May),
+		},
+		{
+			name: "partial_month",
+			ym:   ClampYearMonth(2149, time.June),
+		},
+		{
+			name:    "incompatible",
+			ym:      ClampYearMonth(2149, time.July),
+			wantErr: true,
+		},
+		{
+			name:    "max",
+			ym:      65535,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.ym.Validate()
+			switch {
+			case tt.wantErr && err == nil:
+				t.Errorf("%q.Validate() = nil, want error", tt.ym)
+
+			case !tt.wantErr && err != nil:
+				t.Errorf("%q.Validate() = %q, want nil", tt.ym, err)
+			}
+		})
+	}
+}
+
+func TestYearMonth_SameMonthLastYear(t *testing.T) {
+	ym := ClampYearMonth(2024, time.March)
+	got, err := ym.SameMonthLastYear()
+	if err != nil || got != ClampYearMonth(2023, time.March) {
+		t.Errorf("%v.SameMonthLastYear() = %v, %v; want 2023-03", ym, got, err)
+	}
+	ym = ClampYearMonth(1970, time.December)
+	if got, err := ym.SameMonthLastYear(); err == nil {
+		t.Errorf("%v.SameMonthLastYear() = %v; want error", ym, got)
+	}
+}
+
+func TestYearMonth_Year(t *testing.T) {
+	for _, test := range []struct {
+		ym    YearMonth
+		year  int
+		month time.Month
+	}{
+		{0, 1970, time.January},
+		{11, 1970, time.December},
+		{12, 1971, time.January},
+		{maxDate, 7431, time.April},
+	} {
+		if y, m := test.ym.Year(), test.ym.Month(); y != test.year || m != test.month {
+			t.Errorf("%d: Year, Month = %d, %v; want %d, %v", test.ym, y, m, test.year, test.month)
+		}
+		if got := ClampYearMonth(test.year, test.month); got != test.ym {
+			t.Errorf("ClampYearMonth(%d, %v) = %d; want %d", test.year, test.month, got, test.ym)
+		}
+	}
+}
+
+func TestYearMonth_AddMonths(t *testing.T) {
+	ym := ClampYearMonth(2024, time.November)
+	tests := []struct {
+		ym      YearMonth
+		n       int
+		want    YearMonth
+		wantErr bool
+		clamped YearMonth
+	}{
+		{ym, 0, ym, false, ym},
+		{ym, 2, ClampYearMonth(2025, time.January), false, ClampYearMonth(2025, time.January)},
+		{ym, -23, ClampYearMonth(2022, time.December), false, ClampYearMonth(2022, time.December)},
+		{0, -1, 0, true, 0},
+		{maxDate, 1, 0, true, maxDate},
+		{ym, math.MaxInt32, 0, true, maxDate},
+		{ym, math.MinInt32, 0, true, 0},
+	}
+	for _, test := range tests {
+		got, err := test.ym.AddMonths(test.n)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("%v.AddMonths(%d) = %v, %v; want %v, error %v", test.ym, test.n, got, err, test.want, test.wantErr)
+		}
+		if got := test.ym.ClampAddMonths(test.n); got != test.clamped {
+			t.Errorf("%v.ClampAddMonths(%d) = %v; want %v", test.ym, test.n, got, test.clamped)
+		}
+	}
+
+	a, b := ClampYearMonth(2023, time.March), ClampYearMonth(2024, time.February)
+	if got := b.Sub(a); got != 11 {
+		t.Errorf("%v.Sub(%v) = %d; want 11", b, a, got)
+	}
+	if got := a.Sub(b); got != -11 {
+		t.Errorf("%v.Sub(%v) = %d; want -11", a, b, got)
+	}
+}
+
+func TestNewYearMonth(t *testing.T) {
+	tests := []struct {
+		year    int
+		month   time.Month
+		want    string
+		wantErr bool
+	}{
+		{1970, time.January, "1970-01", false},
+		{2024, time.March, "2024-03", false},
+		{2024, 13, "2025-01", false},
+		{7431, time.April, "7431-04", false},
+		{1969, time.December, "", true},
+		{7431, time.May, "", true},
+	}
+	for _, test := range tests {
+		ym, err := NewYearMonth(test.year, test.month)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("NewYearMonth(%d, %d) = %v; want error", test.year, test.month, ym)
+			}
+			continue
+		}
+		if err != nil || ym.String() != test.want {
+			t.Errorf("NewYearMonth(%d, %d) = %v, %v; want %s", test.year, test.month, ym, err, test.want)
+		}
+	}
+}
+
+func TestParseYearMonth(t *testing.T) {
+	for s, want := range map[string]string{"2024-03": "2024-03", "2024-02-29": "2024-02"} {
+		ym, err := ParseYearMonth(s)
+		if err != nil || ym.String() != want {
+			t.Errorf("ParseYearMonth(%q) = %v, %v; want %s", s, ym, err, want)
+		}
+	}
+	for _, s := range []string{"", "2024-13", "2023-02-29", "1969-12", "2024-3"} {
+		if ym, err := ParseYearMonth(s); err == nil {
+			t.Errorf("ParseYearMonth(%q) = %v; want error", s, ym)
+		}
+	}
+
+	if got := MustParseYearMonth("2024-03"); got != ClampYearMonth(2024, time.March) {
+		t.Errorf("MustParseYearMonth(2024-03) = %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseYearMonth of invalid input did not panic")
+		}
+	}()
+	MustParseYearMonth("2024-13")
+}
+
+func TestYearMonthFromTime(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*3600)
+	tm := time.Date(2024, time.March, 1, 1, 0, 0, 0, tokyo)
+	if ym, err := YearMonthFromTime(tm); err != nil || ym.String() != "2024-03" {
+		t.Errorf("YearMonthFromTime(%v) = %v, %v; want 2024-03", tm, ym, err)
+	}
+	if ym, err := YearMonthFromTime(tm.UTC()); err != nil || ym.String() != "2024-02" {
+		t.Errorf("YearMonthFromTime(%v) = %v, %v; want 2024-02", tm.UTC(), ym, err)
+	}
+}
+
+func TestThisMonth(t *testing.T) {
+	defer func() { DefaultClock = SystemClock }()
+	DefaultClock = fixedClock(time.Date(2024, time.February, 29, 23, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+	if got := ThisMonthUTC(); got.String() != "2024-03" {
+		t.Errorf("ThisMonthUTC() = %v; want 2024-03", got)
+	}
+	DefaultClock = fixedClock(time.Date(2200, time.January, 1, 0, 0, 0, 0, time.UTC))
+	if got := ThisMonthUTC(); got.String() != "2200-01" {
+		t.Errorf("ThisMonthUTC() = %v; want 2200-01", got)
+	}
+	if got := ThisMonth(); got == 0 {
+		t.Errorf("ThisMonth() = %v; want a month in 2199 or 2200", got)
+	}
+}
+
+func TestYearMonth_JSON(t *testing.T) {
+	type statement struct {
+		Period YearMonth  `json:"period"`
+		Prior  *YearMonth `json:"prior"`
+	}
+	s := statement{Period: ClampYearMonth(2024, time.March)}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"period":"2024-03","prior":null}`; string(data) != want {
+		t.Errorf("Marshal = %s; want %s", data, want)
+	}
+
+	got := statement{Period: 5}
+	if err := json.Unmarshal([]byte(`{"period":"2024-03-15","prior":"2024-02"}`), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Period != s.Period || got.Prior == nil || got.Prior.String() != "2024-02" {
+		t.Errorf("Unmarshal = %+v", got)
+	}
+
+	ym := ClampYearMonth(2024, time.March)
+	if err := json.Unmarshal([]byte(`null`), &ym); err != nil || ym.String() != "2024-03" {
+		t.Errorf("Unmarshal(null) = %v, %v; want unchanged 2024-03", ym, err)
+	}
+	if err := json.Unmarshal([]byte(`"2024-13"`), &ym); err == nil {
+		t.Error("Unmarshal of invalid month succeeded")
+	}
+}
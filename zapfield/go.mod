@@ -0,0 +1,12 @@
+module github.com/xtgo/epochdate/zapfield
+
+go 1.19
+
+require (
+	github.com/xtgo/epochdate v0.0.0
+	go.uber.org/zap v1.28.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+replace github.com/xtgo/epochdate => ../
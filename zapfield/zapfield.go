@@ -0,0 +1,74 @@
+// Package zapfield provides zap fields for epochdate values, which render
+// dates as RFC 3339 strings, such as "2024-03-01", or, using the methods of
+// Days, as the number of days since 1970-01-01:
+//
+//	logger.Info("order placed",
+//		zapfield.Date("due", due),
+//		zapfield.Days.Date("due_days", due))
+//
+package zapfield
+
+import (
+	"github.com/xtgo/epochdate"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Encoding selects how dates are rendered. Its methods construct fields
+// using that encoding, so that it is chosen per field.
+//
+type Encoding int
+
+const (
+	// ISO renders dates as RFC 3339 strings.
+	ISO Encoding = iota
+
+	// Days renders dates as the number of days since 1970-01-01.
+	Days
+)
+
+// Date returns a field holding d, rendered according to e.
+func (e Encoding) Date(key string, d epochdate.Date) zap.Field {
+	if e == Days {
+		return zap.Int(key, int(d))
+	}
+	return zap.String(key, d.String())
+}
+
+// Dates returns a field holding an array of dates, rendered according to e.
+func (e Encoding) Dates(key string, ds []epochdate.Date) zap.Field {
+	return zap.Array(key, dates{ds, e})
+}
+
+// Date returns a field holding d as an RFC 3339 string.
+func Date(key string, d epochdate.Date) zap.Field {
+	return ISO.Date(key, d)
+}
+
+// Dates returns a field holding an array of dates as RFC 3339 strings.
+func Dates(key string, ds []epochdate.Date) zap.Field {
+	return ISO.Dates(key, ds)
+}
+
+// YearMonth returns a field holding ym as a year-month string, such as
+// "2024-03".
+//
+func YearMonth(key string, ym epochdate.YearMonth) zap.Field {
+	return zap.Stringer(key, ym)
+}
+
+type dates struct {
+	ds  []epochdate.Date
+	enc Encoding
+}
+
+func (a dates) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, d := range a.ds {
+		if a.enc == Days {
+			enc.AppendInt(int(d))
+		} else {
+			enc.AppendString(d.String())
+		}
+	}
+	return nil
+}
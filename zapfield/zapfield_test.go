@@ -0,0 +1,38 @@
+package zapfield
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/xtgo/epochdate"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestFields(t *testing.T) {
+	var b bytes.Buffer
+	enc := zapcore.NewJSONEncoder(zapcore.EncoderConfig{MessageKey: "msg"})
+	logger := zap.New(zapcore.NewCore(enc, zapcore.AddSync(&b), zap.InfoLevel))
+
+	d := epochdate.MustParseRFC("2024-03-01")
+	tests := []struct {
+		mode Encoding
+		want string
+	}{
+		{ISO, `{"msg":"m","due":"2024-03-01","all":["2024-03-01","2024-03-02"],"month":"2024-03"}` + "\n"},
+		{Days, `{"msg":"m","due":19783,"all":[19783,19784],"month":"2024-03"}` + "\n"},
+	}
+	for _, tt := range tests {
+		b.Reset()
+		logger.Info("m", tt.mode.Date("due", d), tt.mode.Dates("all", []epochdate.Date{d, d + 1}), YearMonth("month", d.YearMonth()))
+		if b.String() != tt.want {
+			t.Errorf("mode %d: output = %s, want %s", tt.mode, b.String(), tt.want)
+		}
+	}
+
+	b.Reset()
+	logger.Info("m", Date("due", d), Dates("all", []epochdate.Date{d, d + 1}), Days.Date("days", d))
+	if want := `{"msg":"m","due":"2024-03-01","all":["2024-03-01","2024-03-02"],"days":19783}` + "\n"; b.String() != want {
+		t.Errorf("output = %s, want %s", b.String(), want)
+	}
+}
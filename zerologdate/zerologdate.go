@@ -0,0 +1,81 @@
+// Package zerologdate renders epochdate values in zerolog events, as RFC
+// 3339 strings, such as "2024-03-01", or, using the methods of Days, as the
+// number of days since 1970-01-01:
+//
+//	log.Info().
+//		Func(zerologdate.Date("due", due)).
+//		Func(zerologdate.Days.Date("due_days", due)).
+//		Array("holidays", zerologdate.Dates(holidays)).
+//		Msg("order placed")
+//
+package zerologdate
+
+import (
+	"github.com/rs/zerolog"
+	"github.com/xtgo/epochdate"
+)
+
+// Encoding selects how dates are rendered. Its methods add dates to events
+// using that encoding, so that it is chosen per field.
+//
+type Encoding int
+
+const (
+	// ISO renders dates as RFC 3339 strings.
+	ISO Encoding = iota
+
+	// Days renders dates as the number of days since 1970-01-01.
+	Days
+)
+
+// Date returns a function which adds d to an event, rendered according to
+// e, for use with zerolog.Event.Func.
+//
+func (e Encoding) Date(key string, d epochdate.Date) func(ev *zerolog.Event) {
+	return func(ev *zerolog.Event) {
+		if e == Days {
+			ev.Int(key, int(d))
+		} else {
+			ev.Str(key, d.String())
+		}
+	}
+}
+
+// Dates returns ds as an array which may be added to events with
+// zerolog.Event.Array, rendered according to e.
+//
+func (e Encoding) Dates(ds []epochdate.Date) zerolog.LogArrayMarshaler {
+	return dates{ds, e}
+}
+
+// Date returns a function which adds d to an event as an RFC 3339 string,
+// for use with zerolog.Event.Func.
+//
+func Date(key string, d epochdate.Date) func(e *zerolog.Event) {
+	return ISO.Date(key, d)
+}
+
+// Dates is a slice of dates which may be added to events with
+// zerolog.Event.Array, as RFC 3339 strings.
+//
+type Dates []epochdate.Date
+
+// MarshalZerologArray implements zerolog.LogArrayMarshaler.
+func (ds Dates) MarshalZerologArray(a *zerolog.Array) {
+	dates{ds, ISO}.MarshalZerologArray(a)
+}
+
+type dates struct {
+	ds  []epochdate.Date
+	enc Encoding
+}
+
+func (a dates) MarshalZerologArray(arr *zerolog.Array) {
+	for _, d := range a.ds {
+		if a.enc == Days {
+			arr.Int(int(d))
+		} else {
+			arr.Str(d.String())
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package zerologdate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/xtgo/epochdate"
+)
+
+func TestEvents(t *testing.T) {
+	var b bytes.Buffer
+	logger := zerolog.New(&b)
+
+	d := epochdate.MustParseRFC("2024-03-01")
+	tests := []struct {
+		mode Encoding
+		want string
+	}{
+		{ISO, `{"level":"info","due":"2024-03-01","all":["2024-03-01","2024-03-02"],"message":"m"}` + "\n"},
+		{Days, `{"level":"info","due":19783,"all":[19783,19784],"message":"m"}` + "\n"},
+	}
+	for _, tt := range tests {
+		b.Reset()
+		logger.Info().Func(tt.mode.Date("due", d)).Array("all", tt.mode.Dates([]epochdate.Date{d, d + 1})).Msg("m")
+		if b.String() != tt.want {
+			t.Errorf("mode %d: output = %s, want %s", tt.mode, b.String(), tt.want)
+		}
+	}
+
+	b.Reset()
+	logger.Info().Func(Date("due", d)).Array("all", Dates{d, d + 1}).Func(Days.Date("days", d)).Msg("m")
+	if want := `{"level":"info","due":"2024-03-01","all":["2024-03-01","2024-03-02"],"days":19783,"message":"m"}` + "\n"; b.String() != want {
+		t.Errorf("output = %s, want %s", b.String(), want)
+	}
+}
@@ -0,0 +1,112 @@
+package epochdate
+
+import "time"
+
+// maxZoneOffset bounds the magnitude of the UTC offset, in seconds, of any
+// location during the representable range.
+const maxZoneOffset = 18 * 60 * 60
+
+// wallIn returns the earliest instant at which a wall clock in loc reads the
+// given number of seconds and nanoseconds past midnight on Jan 1 1970. If the
+// wall clock skips over that reading, as at the start of daylight saving
+// time, the reading is interpreted using the offset in effect before the
+// skip (moving the result forward by the length of the skip), and exact is
+// false.
+//
+func wallIn(wall int64, nsec int, loc *time.Location) (t time.Time, exact bool) {
+	clock := func(sec int64) int64 {
+		_, offset := time.Unix(sec, 0).In(loc).Zone()
+		return sec + int64(offset)
+	}
+
+	// the common case: the offset does not change near the wall time.
+	_, offset := time.Unix(wall, 0).In(loc).Zone()
+	sec := wall - int64(offset)
+	if clock(sec) != wall || clock(sec-1) >= wall {
+		// search for the first second at which the clock reads wall or later.
+		lo, hi := wall-maxZoneOffset, wall+maxZoneOffset
+		for lo < hi {
+			mid := lo + (hi-lo)/2
+			if clock(mid) < wall {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		sec = lo
+	}
+
+	exact = clock(sec) == wall
+	if !exact {
+		sec += wall - clock(sec-1) - 1
+	}
+	return time.Unix(sec, int64(nsec)).In(loc), exact
+}
+
+// MidnightIn returns the first instant of the receiver's date in loc, and
+// whether that instant is midnight. Some locations have historically begun
+// daylight saving time at midnight, skipping it entirely; on such dates, the
+// returned time is the first instant after the skip (typically 01:00) and ok
+// is false. Where midnight occurs twice, the earlier instant is returned.
+//
+func (d Date) MidnightIn(loc *time.Location) (t time.Time, ok bool) {
+	return wallIn(d.Unix(), 0, loc)
+}
+
+// BoundsIn returns the half-open interval [start, end) of instants that fall
+// on the receiver's date in loc. The interval is usually 24 hours long, but
+// may be shorter or longer on days with daylight saving transitions.
+//
+func (d Date) BoundsIn(loc *time.Location) (start, end time.Time) {
+	start, _ = wallIn(d.Unix(), 0, loc)
+	end, _ = wallIn(d.Unix()+day, 0, loc)
+	return start, end
+}
+
+// ContainsTime reports whether the instant t falls on the receiver's date in
+// loc, as determined by BoundsIn.
+//
+func (d Date) ContainsTime(t time.Time, loc *time.Location) bool {
+	start, end := d.BoundsIn(loc)
+	return !t.Before(start) && t.Before(end)
+}
+
+// SameDateIn reports whether the instants t1 and t2 fall on the same date in
+// loc. Unlike comparing the results of NewFromTimeIn, SameDateIn is not
+// limited to the range of representable dates.
+//
+func SameDateIn(t1, t2 time.Time, loc *time.Location) bool {
+	y1, m1, d1 := t1.In(loc).Date()
+	y2, m2, d2 := t2.In(loc).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// Noon returns 12:00 on the receiver's date in loc. Daylight saving
+// transitions occur overnight in practice, so unlike midnight, noon reliably
+// exists exactly once per day, making it a robust anchor for daily schedules
+// and for converting between Date and time.Time across locations.
+//
+func (d Date) Noon(loc *time.Location) time.Time {
+	t, _ := wallIn(d.Unix()+day/2, 0, loc)
+	return t
+}
+
+// At returns the instant at the given time of day on the receiver's date in
+// loc. Clock values outside their usual ranges are normalized, as with
+// time.Date. Where daylight saving transitions make the time of day
+// ambiguous, the earlier of the two instants is returned. Where a transition
+// skips over the time of day, it is interpreted using the UTC offset in
+// effect before the transition, so that 02:30 on a day that skips from 02:00
+// to 03:00 yields 03:30.
+//
+func (d Date) At(hour, min, sec, nsec int, loc *time.Location) time.Time {
+	wall := d.Unix() + int64(hour)*60*60 + int64(min)*60 + int64(sec)
+	wall += int64(nsec / nsPerSec)
+	nsec %= nsPerSec
+	if nsec < 0 {
+		wall--
+		nsec += nsPerSec
+	}
+	t, _ := wallIn(wall, nsec, loc)
+	return t
+}
@@ -0,0 +1,370 @@
+package epochdate
+
+import (
+	"testing"
+	"time"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	return loc
+}
+
+func TestDate_MidnightIn(t *testing.T) {
+	tests := []struct {
+		name   string
+		loc    string
+		date   Date
+		want   time.Time
+		wantOK bool
+	}{
+		{
+			name:   "utc",
+			loc:    "UTC",
+			date:   ClampFromDate(2020, 1, 26),
+			want:   time.Date(2020, 1, 26, 0, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name:   "ordinary_day",
+			loc:    "America/Sao_Paulo",
+			date:   ClampFromDate(2018, 11, 3),
+			want:   time.Date(2018, 11, 3, 3, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			// DST began at midnight, so the day started at 01:00 -02.
+			name:   "skipped_midnight",
+			loc:    "America/Sao_Paulo",
+			date:   ClampFromDate(2018, 11, 4),
+			want:   time.Date(2018, 11, 4, 3, 0, 0, 0, time.UTC),
+			wantOK: false,
+		},
+		{
+			name:   "day_after_skipped_midnight",
+			loc:    "America/Sao_Paulo",
+			date:   ClampFromDate(2018, 11, 5),
+			want:   time.Date(2018, 11, 5, 2, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			// DST ended at 01:00, so midnight occurred twice.
+			name:   "repeated_midnight",
+			loc:    "America/Havana",
+			date:   ClampFromDate(2019, 11, 3),
+			want:   time.Date(2019, 11, 3, 4, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+		{
+			name:   "max_east",
+			loc:    "Pacific/Kiritimati",
+			date:   maxDate,
+			want:   time.Date(2149, 6, 5, 10, 0, 0, 0, time.UTC),
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := loadLocation(t, tt.loc)
+
+			got, ok := tt.date.MidnightIn(loc)
+			if !got.Equal(tt.want) || ok != tt.wantOK {
+				t.Errorf("%q.MidnightIn(%q) = %q, %v, want %q, %v", tt.date, loc, got, ok, tt.want, tt.wantOK)
+			}
+
+			if got.Location() != loc {
+				t.Errorf("%q.MidnightIn(%q).Location() = %q, want %[2]q", tt.date, loc, got.Location())
+			}
+
+			if in := tt.date.In(loc); !in.Equal(tt.want) {
+				t.Errorf("%q.In(%q) = %q, want %q", tt.date, loc, in, tt.want)
+			}
+
+			if date, err := NewFromTime(got); err != nil || date != tt.date {
+				t.Errorf("NewFromTime(%q) = %q, %v, want %q, nil", got, date, err, tt.date)
+			}
+		})
+	}
+}
+
+func TestDate_BoundsIn(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  string
+		date Date
+		want time.Duration
+	}{
+		{
+			name: "utc",
+			loc:  "UTC",
+			date: ClampFromDate(2020, 1, 26),
+			want: 24 * time.Hour,
+		},
+		{
+			name: "dst_start",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2018, 11, 4),
+			want: 23 * time.Hour,
+		},
+		{
+			name: "dst_end",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2019, 2, 16),
+			want: 25 * time.Hour,
+		},
+		{
+			name: "half_hour_dst_start",
+			loc:  "Australia/Lord_Howe",
+			date: ClampFromDate(2019, 10, 6),
+			want: 23*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "max",
+			loc:  "UTC",
+			date: maxDate,
+			want: 24 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := loadLocation(t, tt.loc)
+
+			start, end := tt.date.BoundsIn(loc)
+			if got := end.Sub(start); got != tt.want {
+				t.Errorf("%q.BoundsIn(%q) spans %v, want %v", tt.date, loc, got, tt.want)
+			}
+
+			if want := tt.date.In(loc); !start.Equal(want) {
+				t.Errorf("%q.BoundsIn(%q) starts at %q, want %q", tt.date, loc, start, want)
+			}
+
+			if date, err := NewFromTime(end.Add(-1)); err != nil || date != tt.date {
+				t.Errorf("NewFromTime(%q) = %q, %v, want %q, nil", end.Add(-1), date, err, tt.date)
+			}
+		})
+	}
+}
+
+func TestDate_ContainsTime(t *testing.T) {
+	loc := loadLocation(t, "America/Sao_Paulo")
+	date := ClampFromDate(2018, 11, 4)
+
+	tests := []struct {
+		name  string
+		input time.Time
+		want  bool
+	}{
+		{
+			name:  "previous_day",
+			input: time.Date(2018, 11, 3, 23, 59, 59, 999999999, loc),
+			want:  false,
+		},
+		{
+			name:  "start",
+			input: time.Date(2018, 11, 4, 1, 0, 0, 0, loc),
+			want:  true,
+		},
+		{
+			name:  "end",
+			input: time.Date(2018, 11, 4, 23, 59, 59, 999999999, loc),
+			want:  true,
+		},
+		{
+			name:  "next_day",
+			input: time.Date(2018, 11, 5, 0, 0, 0, 0, loc),
+			want:  false,
+		},
+		{
+			name:  "other_zone",
+			input: time.Date(2018, 11, 5, 1, 0, 0, 0, time.UTC),
+			want:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := date.ContainsTime(tt.input, loc)
+			if got != tt.want {
+				t.Errorf("%q.ContainsTime(%q, %q) = %v, want %v", date, tt.input, loc, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameDateIn(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name string
+		t1   time.Time
+		t2   time.Time
+		loc  *time.Location
+		want bool
+	}{
+		{
+			name: "same_instant",
+			t1:   time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC),
+			t2:   time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC),
+			loc:  time.UTC,
+			want: true,
+		},
+		{
+			name: "same_utc_date_different_local_date",
+			t1:   time.Date(2020, 3, 1, 1, 0, 0, 0, time.UTC),
+			t2:   time.Date(2020, 3, 1, 23, 0, 0, 0, time.UTC),
+			loc:  tokyo,
+			want: false,
+		},
+		{
+			name: "different_utc_date_same_local_date",
+			t1:   time.Date(2020, 2, 29, 15, 0, 0, 0, time.UTC),
+			t2:   time.Date(2020, 3, 1, 14, 59, 59, 0, time.UTC),
+			loc:  tokyo,
+			want: true,
+		},
+		{
+			name: "out_of_range",
+			t1:   time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC),
+			t2:   time.Date(1900, 1, 1, 23, 0, 0, 0, time.UTC),
+			loc:  time.UTC,
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SameDateIn(tt.t1, tt.t2, tt.loc)
+			if got != tt.want {
+				t.Errorf("SameDateIn(%q, %q, %q) = %v, want %v", tt.t1, tt.t2, tt.loc, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Noon(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  string
+		date Date
+		want time.Time
+	}{
+		{
+			name: "utc",
+			loc:  "UTC",
+			date: ClampFromDate(2020, 1, 26),
+			want: time.Date(2020, 1, 26, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "dst_start",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2018, 11, 4),
+			want: time.Date(2018, 11, 4, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "dst_end",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2019, 2, 16),
+			want: time.Date(2019, 2, 16, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "max",
+			loc:  "UTC",
+			date: maxDate,
+			want: time.Date(2149, 6, 6, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := loadLocation(t, tt.loc)
+
+			got := tt.date.Noon(loc)
+			if !got.Equal(tt.want) {
+				t.Errorf("%q.Noon(%q) = %q, want %q", tt.date, loc, got, tt.want)
+			}
+
+			if h, m, s := got.Clock(); h != 12 || m != 0 || s != 0 {
+				t.Errorf("%q.Noon(%q).Clock() = %d:%02d:%02d, want 12:00:00", tt.date, loc, h, m, s)
+			}
+		})
+	}
+}
+
+func TestDate_At(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  string
+		date Date
+		hour int
+		min  int
+		sec  int
+		nsec int
+		want time.Time
+	}{
+		{
+			name: "utc",
+			loc:  "UTC",
+			date: ClampFromDate(2020, 1, 26),
+			hour: 9,
+			want: time.Date(2020, 1, 26, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "all_fields",
+			loc:  "UTC",
+			date: ClampFromDate(2020, 1, 26),
+			hour: 23,
+			min:  59,
+			sec:  59,
+			nsec: 999999999,
+			want: time.Date(2020, 1, 26, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			name: "normalized",
+			loc:  "UTC",
+			date: ClampFromDate(2020, 1, 26),
+			hour: 24,
+			nsec: -1,
+			want: time.Date(2020, 1, 26, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			name: "gap",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2018, 11, 4),
+			min:  30,
+			want: time.Date(2018, 11, 4, 3, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "overlap",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2019, 2, 16),
+			hour: 23,
+			min:  30,
+			want: time.Date(2019, 2, 17, 1, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "after_overlap",
+			loc:  "America/Sao_Paulo",
+			date: ClampFromDate(2019, 2, 17),
+			hour: 9,
+			want: time.Date(2019, 2, 17, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc := loadLocation(t, tt.loc)
+
+			got := tt.date.At(tt.hour, tt.min, tt.sec, tt.nsec, loc)
+			if !got.Equal(tt.want) {
+				t.Errorf("%q.At(%d, %d, %d, %d, %q) = %q, want %q",
+					tt.date, tt.hour, tt.min, tt.sec, tt.nsec, loc, got, tt.want)
+			}
+		})
+	}
+}
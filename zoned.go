@@ -0,0 +1,128 @@
+package epochdate
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+var errZonedDateSyntax = errors.New("epochdate: ZonedDate must have the form 2006-01-02[Area/Location]")
+
+// ZonedDate pairs a Date with the location in which it is observed, such as
+// the business date of a customer in a particular time zone. The location is
+// retained by name rather than as a UTC offset, so that the instants covered
+// by the date are correct even when the date spans a daylight saving
+// transition. A nil Loc is treated as UTC.
+//
+type ZonedDate struct {
+	Date Date
+	Loc  *time.Location
+}
+
+// TodayZoned returns the current date in loc, paired with loc. See TodayIn.
+func TodayZoned(loc *time.Location) ZonedDate {
+	return ZonedDate{Date: TodayIn(loc), Loc: loc}
+}
+
+// ZonedFromTime returns the date of t relative to its location, paired with
+// that location.
+//
+func ZonedFromTime(t time.Time) (ZonedDate, error) {
+	d, err := NewFromTime(t)
+	if err != nil {
+		return ZonedDate{}, err
+	}
+	return ZonedDate{Date: d, Loc: t.Location()}, nil
+}
+
+// Location returns the receiver's location, which is UTC if Loc is nil.
+func (z ZonedDate) Location() *time.Location {
+	if z.Loc == nil {
+		return time.UTC
+	}
+	return z.Loc
+}
+
+// Start returns the first instant of the receiver's date in its location.
+// See Date.In.
+//
+func (z ZonedDate) Start() time.Time {
+	return z.Date.In(z.Location())
+}
+
+// Bounds returns the half-open interval of instants covered by the
+// receiver. See Date.BoundsIn.
+//
+func (z ZonedDate) Bounds() (start, end time.Time) {
+	return z.Date.BoundsIn(z.Location())
+}
+
+// Contains reports whether the instant t falls on the receiver's date in its
+// location.
+//
+func (z ZonedDate) Contains(t time.Time) bool {
+	return z.Date.ContainsTime(t, z.Location())
+}
+
+// In returns the date in loc at the receiver's first instant. For example,
+// converting 2020-03-01[Asia/Tokyo] to UTC yields 2020-02-29[UTC].
+//
+func (z ZonedDate) In(loc *time.Location) (ZonedDate, error) {
+	d, err := NewFromTimeIn(z.Start(), loc)
+	if err != nil {
+		return ZonedDate{}, err
+	}
+	return ZonedDate{Date: d, Loc: loc}, nil
+}
+
+// Equal reports whether the receiver and other represent the same date in
+// the same named location.
+//
+func (z ZonedDate) Equal(other ZonedDate) bool {
+	return z.Date == other.Date && z.Location().String() == other.Location().String()
+}
+
+// Before reports whether the receiver begins before other.
+func (z ZonedDate) Before(other ZonedDate) bool {
+	return z.Start().Before(other.Start())
+}
+
+// After reports whether the receiver begins after other.
+func (z ZonedDate) After(other ZonedDate) bool {
+	return z.Start().After(other.Start())
+}
+
+// String returns the date followed by the bracketed location name, of the
+// form "2006-01-02[Europe/Paris]".
+//
+func (z ZonedDate) String() string {
+	return z.Date.String() + "[" + z.Location().String() + "]"
+}
+
+// MarshalText implements encoding.TextMarshaler, using the format produced by
+// String.
+//
+func (z ZonedDate) MarshalText() ([]byte, error) {
+	return []byte(z.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the format
+// produced by String. The location is resolved with time.LoadLocation.
+//
+func (z *ZonedDate) UnmarshalText(data []byte) error {
+	s := string(data)
+	i := strings.IndexByte(s, '[')
+	if i < 0 || !strings.HasSuffix(s, "]") {
+		return errZonedDateSyntax
+	}
+	d, err := ParseRFC(s[:i])
+	if err != nil {
+		return err
+	}
+	loc, err := time.LoadLocation(s[i+1 : len(s)-1])
+	if err != nil {
+		return err
+	}
+	*z = ZonedDate{Date: d, Loc: loc}
+	return nil
+}
@@ -0,0 +1,115 @@
+package epochdate
+
+import (
+	"encoding"
+	"testing"
+	"time"
+)
+
+var (
+	_ encoding.TextMarshaler   = ZonedDate{}
+	_ encoding.TextUnmarshaler = new(ZonedDate)
+)
+
+func TestZonedFromTime(t *testing.T) {
+	loc := loadLocation(t, "Asia/Tokyo")
+	input := time.Date(2020, 3, 1, 8, 0, 0, 0, loc)
+
+	got, err := ZonedFromTime(input)
+	if err != nil {
+		t.Fatalf("ZonedFromTime(%q) = %q [err], want nil", input, err)
+	}
+
+	want := ZonedDate{Date: ClampFromDate(2020, 3, 1), Loc: loc}
+	if !got.Equal(want) {
+		t.Errorf("ZonedFromTime(%q) = %q, want %q", input, got, want)
+	}
+
+	if !got.Contains(input) {
+		t.Errorf("%q.Contains(%q) = false, want true", got, input)
+	}
+
+	utc, err := got.In(time.UTC)
+	if err != nil {
+		t.Fatalf("%q.In(UTC) = %q [err], want nil", got, err)
+	}
+
+	want = ZonedDate{Date: ClampFromDate(2020, 2, 29), Loc: time.UTC}
+	if !utc.Equal(want) {
+		t.Errorf("%q.In(UTC) = %q, want %q", got, utc, want)
+	}
+
+	if !utc.Before(got) || !got.After(utc) {
+		t.Errorf("%q should begin before %q", utc, got)
+	}
+}
+
+func TestZonedDate_Bounds(t *testing.T) {
+	loc := loadLocation(t, "America/Sao_Paulo")
+	z := ZonedDate{Date: ClampFromDate(2018, 11, 4), Loc: loc}
+
+	start, end := z.Bounds()
+	if got, want := end.Sub(start), 23*time.Hour; got != want {
+		t.Errorf("%q.Bounds() spans %v, want %v", z, got, want)
+	}
+
+	if got := z.Start(); !got.Equal(start) {
+		t.Errorf("%q.Start() = %q, want %q", z, got, start)
+	}
+}
+
+func TestZonedDate_String(t *testing.T) {
+	loc := loadLocation(t, "Europe/Paris")
+
+	tests := []struct {
+		name  string
+		input ZonedDate
+		want  string
+	}{
+		{
+			name:  "nil_location",
+			input: ZonedDate{},
+			want:  "1970-01-01[UTC]",
+		},
+		{
+			name:  "named_location",
+			input: ZonedDate{Date: ClampFromDate(2024, 3, 1), Loc: loc},
+			want:  "2024-03-01[Europe/Paris]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.input.String()
+			if got != tt.want {
+				t.Errorf("%#v.String() = %q, want %q", tt.input, got, tt.want)
+			}
+
+			var z ZonedDate
+			err := z.UnmarshalText([]byte(got))
+			if err != nil {
+				t.Errorf("ZonedDate.UnmarshalText(%q) = %q [err], want nil", got, err)
+			} else if !z.Equal(tt.input) {
+				t.Errorf("ZonedDate.UnmarshalText(%q) -> %q, want %q", got, z, tt.input)
+			}
+		})
+	}
+}
+
+func TestZonedDate_UnmarshalText_error(t *testing.T) {
+	inputs := []string{
+		"",
+		"2024-03-01",
+		"2024-03-01[Europe/Paris",
+		"blah[UTC]",
+		"2024-03-01[No/Such_Zone]",
+	}
+
+	for _, input := range inputs {
+		var z ZonedDate
+		err := z.UnmarshalText([]byte(input))
+		if err == nil {
+			t.Errorf("ZonedDate.UnmarshalText(%q) = nil, want error", input)
+		}
+	}
+}